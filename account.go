@@ -0,0 +1,200 @@
+package twiliolo
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// AccountServiceInterface is the interface of an AccountService
+type AccountServiceInterface interface {
+	Create(string, ...option.RequestOption) (*Account, error)
+	Get(string, ...option.RequestOption) (*Account, error)
+	Suspend(string, ...option.RequestOption) (*Account, error)
+	Reactivate(string, ...option.RequestOption) (*Account, error)
+	Close(string, ...option.RequestOption) (*Account, error)
+	List(...option.RequestOption) (*AccountList, error)
+	ListNextPage(*AccountList) (*AccountList, error)
+	Iter(...option.RequestOption) *Iterator[*Account]
+	CreateContext(context.Context, string, ...option.RequestOption) (*Account, error)
+	GetContext(context.Context, string, ...option.RequestOption) (*Account, error)
+	SuspendContext(context.Context, string, ...option.RequestOption) (*Account, error)
+	ReactivateContext(context.Context, string, ...option.RequestOption) (*Account, error)
+	CloseContext(context.Context, string, ...option.RequestOption) (*Account, error)
+	ListContext(context.Context, ...option.RequestOption) (*AccountList, error)
+	ListNextPageContext(context.Context, *AccountList) (*AccountList, error)
+	IterContext(context.Context, ...option.RequestOption) *Iterator[*Account]
+}
+
+// AccountService handles communication with the Account related methods,
+// mostly used to manage the subaccounts of the main account.
+type AccountService service
+
+// AccountStatus is the status of an Account.
+type AccountStatus string
+
+// Possible values of an AccountStatus.
+const (
+	AccountStatusActive    AccountStatus = "active"
+	AccountStatusSuspended AccountStatus = "suspended"
+	AccountStatusClosed    AccountStatus = "closed"
+)
+
+// Account represents a Twilio account or subaccount.
+type Account struct {
+	Sid             string            `json:"sid"`
+	OwnerAccountSid string            `json:"owner_account_sid"`
+	FriendlyName    string            `json:"friendly_name"`
+	Status          AccountStatus     `json:"status"`
+	Type            string            `json:"type"`
+	AuthToken       string            `json:"auth_token"`
+	DateCreated     time.Time         `json:"date_created"`
+	DateUpdated     time.Time         `json:"date_updated"`
+	URI             string            `json:"uri"`
+	SubresourceURIs map[string]string `json:"subresource_uris"`
+}
+
+// UnmarshalJSON decodes an Account, parsing its RFC 2822 dates.
+func (a *Account) UnmarshalJSON(data []byte) error {
+	type account Account
+
+	raw := struct {
+		*account
+		DateCreated string `json:"date_created"`
+		DateUpdated string `json:"date_updated"`
+	}{account: (*account)(a)}
+
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	a.DateCreated, err = parseDate(raw.DateCreated)
+	if err != nil {
+		return err
+	}
+
+	a.DateUpdated, err = parseDate(raw.DateUpdated)
+
+	return err
+}
+
+// MarshalJSON encodes an Account, formatting its dates in RFC 2822 like Twilio.
+func (a Account) MarshalJSON() ([]byte, error) {
+	type account Account
+
+	return json.Marshal(struct {
+		account
+		DateCreated string `json:"date_created"`
+		DateUpdated string `json:"date_updated"`
+	}{
+		account:     account(a),
+		DateCreated: formatDate(a.DateCreated),
+		DateUpdated: formatDate(a.DateUpdated),
+	})
+}
+
+// accountsURI is the URI of the Accounts, relative to the API root as they are not scoped under the account of the client.
+const accountsURI = "/" + VERSION + "/Accounts"
+
+// Create creates a new subaccount of the authenticated account.
+// Doc: https://www.twilio.com/docs/iam/api/account#create-an-account-resource
+func (s *AccountService) Create(friendlyName string, requestOptions ...option.RequestOption) (*Account, error) {
+	return s.CreateContext(context.Background(), friendlyName, requestOptions...)
+}
+
+// CreateContext performs the same call as Create, bound to the given context.
+func (s *AccountService) CreateContext(ctx context.Context, friendlyName string, requestOptions ...option.RequestOption) (*Account, error) {
+	values := url.Values{}
+	if friendlyName != "" {
+		values.Set("FriendlyName", friendlyName)
+	}
+
+	return s.post(ctx, accountsURI+".json", requestOptions, values)
+}
+
+// Get performs a call to the twilio API to retrieve an Account with its Sid.
+// Doc: https://www.twilio.com/docs/iam/api/account#fetch-an-account-resource
+func (s *AccountService) Get(sid string, requestOptions ...option.RequestOption) (*Account, error) {
+	return s.GetContext(context.Background(), sid, requestOptions...)
+}
+
+// GetContext performs the same call as Get, bound to the given context.
+func (s *AccountService) GetContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*Account, error) {
+	if sid == "" {
+		return nil, ErrAccountMissingData
+	}
+
+	res, err := s.Client.GetContext(ctx, accountsURI+"/"+sid+".json", requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	account := new(Account)
+	err = json.Unmarshal(res, account)
+
+	return account, err
+}
+
+// Suspend suspends a subaccount, its activity is stopped until it is reactivated.
+// Doc: https://www.twilio.com/docs/iam/api/account#update-an-account-resource
+func (s *AccountService) Suspend(sid string, requestOptions ...option.RequestOption) (*Account, error) {
+	return s.SuspendContext(context.Background(), sid, requestOptions...)
+}
+
+// SuspendContext performs the same call as Suspend, bound to the given context.
+func (s *AccountService) SuspendContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*Account, error) {
+	return s.updateStatus(ctx, sid, AccountStatusSuspended, requestOptions)
+}
+
+// Reactivate reactivates a suspended subaccount.
+// Doc: https://www.twilio.com/docs/iam/api/account#update-an-account-resource
+func (s *AccountService) Reactivate(sid string, requestOptions ...option.RequestOption) (*Account, error) {
+	return s.ReactivateContext(context.Background(), sid, requestOptions...)
+}
+
+// ReactivateContext performs the same call as Reactivate, bound to the given context.
+func (s *AccountService) ReactivateContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*Account, error) {
+	return s.updateStatus(ctx, sid, AccountStatusActive, requestOptions)
+}
+
+// Close definitively closes a subaccount and releases its phone numbers.
+// Doc: https://www.twilio.com/docs/iam/api/account#update-an-account-resource
+func (s *AccountService) Close(sid string, requestOptions ...option.RequestOption) (*Account, error) {
+	return s.CloseContext(context.Background(), sid, requestOptions...)
+}
+
+// CloseContext performs the same call as Close, bound to the given context.
+func (s *AccountService) CloseContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*Account, error) {
+	return s.updateStatus(ctx, sid, AccountStatusClosed, requestOptions)
+}
+
+func (s *AccountService) updateStatus(ctx context.Context, sid string, status AccountStatus, requestOptions []option.RequestOption) (*Account, error) {
+	if sid == "" {
+		return nil, ErrAccountMissingData
+	}
+
+	updates := url.Values{}
+	updates.Set("Status", string(status))
+
+	return s.post(ctx, accountsURI+"/"+sid+".json", requestOptions, updates)
+}
+
+func (s *AccountService) post(ctx context.Context, uri string, requestOptions []option.RequestOption, values url.Values) (*Account, error) {
+	body, err := s.Client.PostContext(ctx, uri, requestOptions, values)
+	if err != nil {
+		return nil, err
+	}
+
+	var account Account
+
+	err = json.Unmarshal(body, &account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
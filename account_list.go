@@ -0,0 +1,77 @@
+package twiliolo
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// AccountList represents the response of the Twilio API when calling /Accounts.json
+type AccountList struct {
+	Page            int        `json:"page"`
+	PageSize        int        `json:"page_size"`
+	URI             string     `json:"uri"`
+	FirstPageURI    string     `json:"first_page_uri"`
+	NextPageURI     string     `json:"next_page_uri"`
+	PreviousPageURI string     `json:"previous_page_uri"`
+	Accounts        []*Account `json:"accounts"`
+}
+
+// List retrieves the first page of the Accounts, filtered with the
+// FriendlyName and Status options.
+// Doc: https://www.twilio.com/docs/iam/api/account#read-multiple-account-resources
+func (s *AccountService) List(requestOptions ...option.RequestOption) (*AccountList, error) {
+	return s.ListContext(context.Background(), requestOptions...)
+}
+
+// ListContext performs the same call as List, bound to the given context.
+func (s *AccountService) ListContext(ctx context.Context, requestOptions ...option.RequestOption) (*AccountList, error) {
+	body, err := s.Client.GetContext(ctx, accountsURI+".json", requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	accountList := new(AccountList)
+	err = json.Unmarshal(body, accountList)
+
+	return accountList, err
+}
+
+// ListNextPage retrieves the next page of a given AccountList by following its NextPageURI.
+// If an empty NextPageURI is present in the struct it'll return an error
+func (s *AccountService) ListNextPage(previousList *AccountList) (*AccountList, error) {
+	return s.ListNextPageContext(context.Background(), previousList)
+}
+
+// ListNextPageContext performs the same call as ListNextPage, bound to the given context.
+func (s *AccountService) ListNextPageContext(ctx context.Context, previousList *AccountList) (*AccountList, error) {
+	if previousList == nil || previousList.NextPageURI == "" {
+		return nil, ErrNoNextPage
+	}
+
+	body, err := s.Client.GetContext(ctx, previousList.NextPageURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	accountList := new(AccountList)
+	err = json.Unmarshal(body, accountList)
+
+	return accountList, err
+}
+
+// Iter returns an Iterator over all the Accounts matching the given options.
+func (s *AccountService) Iter(requestOptions ...option.RequestOption) *Iterator[*Account] {
+	return s.IterContext(context.Background(), requestOptions...)
+}
+
+// IterContext performs the same calls as Iter, bound to the given context.
+func (s *AccountService) IterContext(ctx context.Context, requestOptions ...option.RequestOption) *Iterator[*Account] {
+	return newIterator(ctx, s.Client, accountsURI+".json", requestOptions, func(body []byte) ([]*Account, string, error) {
+		list := new(AccountList)
+		err := json.Unmarshal(body, list)
+
+		return list.Accounts, list.NextPageURI, err
+	})
+}
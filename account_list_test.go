@@ -0,0 +1,66 @@
+package twiliolo_test
+
+import (
+	"testing"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountList(t *testing.T) {
+	client := new(internal.MockAPIClient)
+	client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+		assert.Equal(t, testAccountsURL+".json", uri)
+		assert.Equal(t, []option.RequestOption{option.FriendlyName("Customer"), option.Status("suspended")}, requestOptions)
+
+		return []byte(`
+		{
+			"page": 0,
+			"page_size": 50,
+			"uri": "\/2010-04-01\/Accounts.json",
+			"first_page_uri": "\/2010-04-01\/Accounts.json?Page=0&PageSize=50",
+			"previous_page_uri": null,
+			"next_page_uri": "\/2010-04-01\/Accounts.json?Page=1&PageSize=50&PageToken=PAACSubFake",
+			"accounts": [{"sid": "ACSubFake", "status": "suspended"}, {"sid": "ACSubFake2", "status": "suspended"}]
+		}`), nil
+	}
+
+	service := twiliolo.AccountService{Client: client}
+	list, err := service.List(option.FriendlyName("Customer"), option.Status("suspended"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(list.Accounts))
+	assert.Equal(t, twiliolo.AccountStatusSuspended, list.Accounts[1].Status)
+	assert.Equal(t, "/2010-04-01/Accounts.json?Page=1&PageSize=50&PageToken=PAACSubFake", list.NextPageURI)
+}
+
+func TestAccountListNextPage(t *testing.T) {
+	t.Run("OK - Follow next page URI", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/2010-04-01/Accounts.json?Page=1&PageSize=50&PageToken=PAACSubFake", uri)
+			assert.Empty(t, requestOptions)
+
+			return []byte(`{"page": 1, "next_page_uri": null, "accounts": [{"sid": "ACSubFake3"}]}`), nil
+		}
+
+		service := twiliolo.AccountService{Client: client}
+		list, err := service.ListNextPage(&twiliolo.AccountList{NextPageURI: "/2010-04-01/Accounts.json?Page=1&PageSize=50&PageToken=PAACSubFake"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, list.Page)
+		assert.Equal(t, "ACSubFake3", list.Accounts[0].Sid)
+	})
+
+	t.Run("NOK - No next page", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.AccountService{Client: client}
+
+		list, err := service.ListNextPage(&twiliolo.AccountList{})
+
+		assert.Equal(t, twiliolo.ErrNoNextPage, err)
+		assert.Nil(t, list)
+	})
+}
@@ -0,0 +1,122 @@
+package twiliolo_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+const testAccountsURL = "/" + twiliolo.VERSION + "/Accounts"
+
+const testAccountResponse = `
+{
+	"sid": "ACSubFake",
+	"owner_account_sid": "TwilioloFake",
+	"friendly_name": "Customer",
+	"status": "active",
+	"type": "Full",
+	"auth_token": "SubToken",
+	"date_created": "Mon, 16 Aug 2010 03:45:01 +0000",
+	"date_updated": "Mon, 16 Aug 2010 03:45:01 +0000",
+	"uri": "\/2010-04-01\/Accounts\/ACSubFake.json",
+	"subresource_uris": {
+		"calls": "\/2010-04-01\/Accounts\/ACSubFake\/Calls.json"
+	}
+}`
+
+func TestAccountCreate(t *testing.T) {
+	client := new(internal.MockAPIClient)
+	client.PostFn = func(uri string, _ []option.RequestOption, values url.Values) ([]byte, error) {
+		assert.Equal(t, testAccountsURL+".json", uri)
+		assert.Equal(t, "Customer", values.Get("FriendlyName"))
+
+		return []byte(testAccountResponse), nil
+	}
+
+	service := twiliolo.AccountService{Client: client}
+	account, err := service.Create("Customer")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, client.PostCall)
+	assert.Equal(t, "ACSubFake", account.Sid)
+	assert.Equal(t, "TwilioloFake", account.OwnerAccountSid)
+	assert.Equal(t, twiliolo.AccountStatusActive, account.Status)
+	assert.Equal(t, "/2010-04-01/Accounts/ACSubFake/Calls.json", account.SubresourceURIs["calls"])
+}
+
+func TestAccountGet(t *testing.T) {
+	t.Run("OK - Account retrieved", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, testAccountsURL+"/ACSubFake.json", uri)
+
+			return []byte(testAccountResponse), nil
+		}
+
+		service := twiliolo.AccountService{Client: client}
+		account, err := service.Get("ACSubFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Customer", account.FriendlyName)
+		assert.Equal(t, time.Date(2010, time.August, 16, 3, 45, 1, 0, time.UTC), account.DateCreated)
+		assert.Equal(t, time.Date(2010, time.August, 16, 3, 45, 1, 0, time.UTC), account.DateUpdated)
+	})
+
+	t.Run("NOK - Missing sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.AccountService{Client: client}
+
+		account, err := service.Get("")
+
+		assert.Equal(t, twiliolo.ErrAccountMissingData, err)
+		assert.Nil(t, account)
+		assert.Equal(t, 0, client.GetCall)
+	})
+}
+
+func TestAccountStatusUpdate(t *testing.T) {
+	cases := []struct {
+		name     string
+		status   twiliolo.AccountStatus
+		response string
+		update   func(*twiliolo.AccountService, string) (*twiliolo.Account, error)
+	}{
+		{"Suspend", twiliolo.AccountStatusSuspended, `{"sid": "ACSubFake", "status": "suspended"}`, func(s *twiliolo.AccountService, sid string) (*twiliolo.Account, error) { return s.Suspend(sid) }},
+		{"Reactivate", twiliolo.AccountStatusActive, `{"sid": "ACSubFake", "status": "active"}`, func(s *twiliolo.AccountService, sid string) (*twiliolo.Account, error) { return s.Reactivate(sid) }},
+		{"Close", twiliolo.AccountStatusClosed, `{"sid": "ACSubFake", "status": "closed"}`, func(s *twiliolo.AccountService, sid string) (*twiliolo.Account, error) { return s.Close(sid) }},
+	}
+
+	for _, c := range cases {
+		t.Run("OK - "+c.name, func(t *testing.T) {
+			client := new(internal.MockAPIClient)
+			client.PostFn = func(uri string, _ []option.RequestOption, values url.Values) ([]byte, error) {
+				assert.Equal(t, testAccountsURL+"/ACSubFake.json", uri)
+				assert.Equal(t, string(c.status), values.Get("Status"))
+
+				return []byte(c.response), nil
+			}
+
+			service := &twiliolo.AccountService{Client: client}
+			account, err := c.update(service, "ACSubFake")
+
+			assert.NoError(t, err)
+			assert.Equal(t, c.status, account.Status)
+		})
+
+		t.Run("NOK - "+c.name+" missing sid", func(t *testing.T) {
+			client := new(internal.MockAPIClient)
+			service := &twiliolo.AccountService{Client: client}
+
+			account, err := c.update(service, "")
+
+			assert.Equal(t, twiliolo.ErrAccountMissingData, err)
+			assert.Nil(t, account)
+			assert.Equal(t, 0, client.PostCall)
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package twiliolo
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -27,6 +29,12 @@ type TwilioAPIClient struct {
 	AccountSid string
 	AuthToken  string
 	RootURL    string
+	// Credentials is used to authenticate the requests when set, instead of AccountSid and AuthToken.
+	Credentials CredentialProvider
+	// RetryPolicy is applied to every request when set, no retry is performed otherwise.
+	RetryPolicy *RetryPolicy
+	// Limiter paces every attempt of every request when set.
+	Limiter    Limiter
 	httpClient HTTPClient
 }
 
@@ -35,133 +43,295 @@ var _ APIClient = &TwilioAPIClient{}
 // NewTwilioAPIClient instanciates a new TwilioAPIClient
 func NewTwilioAPIClient(accountSid, authToken string, httpClient HTTPClient) *TwilioAPIClient {
 	rootURL := ROOT + "/" + VERSION + "/Accounts/" + accountSid
-	return &TwilioAPIClient{accountSid, authToken, rootURL, httpClient}
+	return &TwilioAPIClient{
+		AccountSid: accountSid,
+		AuthToken:  authToken,
+		RootURL:    rootURL,
+		httpClient: httpClient,
+	}
+}
+
+// NewTwilioAPIClientWithAPIKey instanciates a new TwilioAPIClient authenticating
+// with an API Key instead of the Auth Token of the account.
+func NewTwilioAPIClientWithAPIKey(accountSid, apiKeySid, apiKeySecret string, httpClient HTTPClient) *TwilioAPIClient {
+	return NewTwilioAPIClientWithCredentials(accountSid, StaticCredentials{Username: apiKeySid, Password: apiKeySecret}, httpClient)
+}
+
+// NewTwilioAPIClientWithCredentials instanciates a new TwilioAPIClient
+// authenticating with the credentials returned by the given CredentialProvider.
+func NewTwilioAPIClientWithCredentials(accountSid string, credentials CredentialProvider, httpClient HTTPClient) *TwilioAPIClient {
+	client := NewTwilioAPIClient(accountSid, "", httpClient)
+	client.Credentials = credentials
+
+	return client
+}
+
+// ForAccount returns a copy of the client scoped to the given (sub)account,
+// still authenticating with the credentials of the current one.
+// The accountSid is not checked and must not be empty, TwilioClient.Subaccount validates it.
+func (c *TwilioAPIClient) ForAccount(accountSid string) *TwilioAPIClient {
+	scoped := *c
+	scoped.RootURL = c.apiRoot() + "/" + VERSION + "/Accounts/" + accountSid
+
+	return &scoped
 }
 
 // Post performs a POST HTTP request with the given values.
 func (c *TwilioAPIClient) Post(uri string, requestOptions []option.RequestOption, values url.Values) ([]byte, error) {
+	return c.PostContext(context.Background(), uri, requestOptions, values)
+}
+
+// PostContext performs a POST HTTP request with the given values, bound to the given context.
+func (c *TwilioAPIClient) PostContext(ctx context.Context, uri string, requestOptions []option.RequestOption, values url.Values) ([]byte, error) {
 	uri, err := c.buildURL(uri, requestOptions)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", uri, strings.NewReader(values.Encode()))
+	res, body, err := c.do(ctx, "POST", uri, values)
 	if err != nil {
-		return nil, err
+		return body, err
 	}
 
-	req.SetBasicAuth(c.AccountSid, c.AuthToken)
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return body, checkResponse("POST", uri, res, body, 200, 201)
+}
 
-	res, err := c.httpClient.Do(req)
+// Get performs a GET HTTP request with the given values.
+func (c *TwilioAPIClient) Get(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+	return c.GetContext(context.Background(), uri, requestOptions)
+}
+
+// GetContext performs a GET HTTP request with the given values, bound to the given context.
+func (c *TwilioAPIClient) GetContext(ctx context.Context, uri string, requestOptions []option.RequestOption) ([]byte, error) {
+	uri, err := c.buildURL(uri, requestOptions)
 	if err != nil {
 		return nil, err
 	}
 
-	defer res.Body.Close()
-
-	body, err := ioutil.ReadAll(res.Body)
+	res, body, err := c.do(ctx, "GET", uri, nil)
 	if err != nil {
 		return body, err
 	}
 
-	if res.StatusCode != 200 && res.StatusCode != 201 {
-		if res.StatusCode == 500 {
-			return body, ErrTwilioServer
-		}
-
-		twilioError := new(TwilioError)
-		json.Unmarshal(body, twilioError)
-		return body, twilioError
-
-	}
+	return body, checkResponse("GET", uri, res, body, 200, 201)
+}
 
-	return body, err
+// Delete performs a DELETE HTTP request with the given values.
+func (c *TwilioAPIClient) Delete(uri string, requestOptions []option.RequestOption) error {
+	return c.DeleteContext(context.Background(), uri, requestOptions)
 }
 
-// Get performs a GET HTTP request with the given values.
-func (c *TwilioAPIClient) Get(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+// DeleteContext performs a DELETE HTTP request with the given values, bound to the given context.
+func (c *TwilioAPIClient) DeleteContext(ctx context.Context, uri string, requestOptions []option.RequestOption) error {
 	uri, err := c.buildURL(uri, requestOptions)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	req, err := http.NewRequest("GET", uri, nil)
+	res, body, err := c.do(ctx, "DELETE", uri, nil)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	req.SetBasicAuth(c.AccountSid, c.AuthToken)
+	return checkResponse("DELETE", uri, res, body, 204)
+}
 
-	res, err := c.httpClient.Do(req)
+// Download performs a GET HTTP request and streams the response body to w,
+// without loading it in memory.
+func (c *TwilioAPIClient) Download(uri string, requestOptions []option.RequestOption, w io.Writer) error {
+	return c.DownloadContext(context.Background(), uri, requestOptions, w)
+}
+
+// DownloadContext performs the same request as Download, bound to the given context.
+// Only the attempts failing before anything was written to w are retried.
+func (c *TwilioAPIClient) DownloadContext(ctx context.Context, uri string, requestOptions []option.RequestOption, w io.Writer) error {
+	uri, err := c.buildURL(uri, requestOptions)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	defer res.Body.Close()
+	for attempt := 1; ; attempt++ {
+		// The errors of the CredentialProvider are never retried.
+		username, password, err := c.credentials(ctx)
+		if err != nil {
+			return err
+		}
 
-	body, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return body, err
+		res, body, err := c.limitedDownload(ctx, uri, username, password, w)
+		if res != nil && res.StatusCode == 200 {
+			return err
+		}
+
+		delay, retry := c.RetryPolicy.retryDelay(ctx, "GET", attempt, res, body, err)
+		if !retry {
+			if err != nil {
+				return err
+			}
+
+			return checkResponse("GET", uri, res, body, 200)
+		}
+
+		if err := wait(ctx, delay); err != nil {
+			return err
+		}
 	}
+}
 
-	if res.StatusCode != 200 && res.StatusCode != 201 {
-		if res.StatusCode == 500 {
-			return body, ErrTwilioServer
+// checkResponse returns nil when the response status is one of the expected
+// ones, the TwilioError it describes otherwise.
+func checkResponse(method, uri string, res *http.Response, body []byte, expectedStatuses ...int) error {
+	for _, status := range expectedStatuses {
+		if res.StatusCode == status {
+			return nil
 		}
-		twilioError := new(TwilioError)
-		err := json.Unmarshal(body, &twilioError)
+	}
+
+	twilioError := &TwilioError{
+		HTTPStatus: res.StatusCode,
+		Method:     method,
+		URL:        uri,
+		RequestID:  res.Header.Get("Twilio-Request-Id"),
+		Body:       body,
+	}
+
+	// Error bodies are not always JSON, the TwilioError still describes the response then.
+	json.Unmarshal(body, twilioError)
+	if twilioError.Status == 0 {
+		twilioError.Status = res.StatusCode
+	}
+	if res.StatusCode >= 500 && twilioError.Message == "" {
+		twilioError.Message = ErrTwilioServer.Error()
+	}
+
+	return twilioError
+}
+
+// do sends the request, retrying it according to the RetryPolicy, and returns
+// the last response along with its fully read body.
+func (c *TwilioAPIClient) do(ctx context.Context, method, uri string, values url.Values) (*http.Response, []byte, error) {
+	for attempt := 1; ; attempt++ {
+		// The errors of the CredentialProvider are never retried.
+		username, password, err := c.credentials(ctx)
 		if err != nil {
-			return body, err
+			return nil, nil, err
 		}
 
-		return body, twilioError
+		res, body, err := c.limitedSend(ctx, method, uri, username, password, values)
+
+		delay, retry := c.RetryPolicy.retryDelay(ctx, method, attempt, res, body, err)
+		if !retry {
+			return res, body, err
+		}
+
+		if err := wait(ctx, delay); err != nil {
+			return nil, nil, err
+		}
+	}
+}
+
+func (c *TwilioAPIClient) limitedSend(ctx context.Context, method, uri, username, password string, values url.Values) (*http.Response, []byte, error) {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, nil, err
 	}
+	defer release()
 
-	return body, err
+	return c.send(ctx, method, uri, username, password, values)
 }
 
-// Delete performs a DELETE HTTP request with the given values.
-func (c *TwilioAPIClient) Delete(uri string, requestOptions []option.RequestOption) error {
-	uri, err := c.buildURL(uri, requestOptions)
+// limitedDownload sends a GET request and copies the body to w on success.
+// The body of an unsuccessful response is returned instead.
+func (c *TwilioAPIClient) limitedDownload(ctx context.Context, uri, username, password string, w io.Writer) (*http.Response, []byte, error) {
+	release, err := c.acquire(ctx)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
+	defer release()
 
-	req, err := http.NewRequest("DELETE", uri, nil)
+	req, err := newRequest(ctx, "GET", uri, username, password, nil)
 	if err != nil {
-		return err
+		return nil, nil, err
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode == 200 {
+		_, err = io.Copy(w, res.Body)
+
+		return res, nil, err
 	}
 
-	req.SetBasicAuth(c.AccountSid, c.AuthToken)
+	body, err := ioutil.ReadAll(res.Body)
+
+	return res, body, err
+}
+
+// acquire waits for the Limiter, if any, and returns the function releasing it.
+func (c *TwilioAPIClient) acquire(ctx context.Context) (func(), error) {
+	if c.Limiter == nil {
+		return func() {}, nil
+	}
+
+	return c.Limiter.Acquire(ctx)
+}
+
+func (c *TwilioAPIClient) send(ctx context.Context, method, uri, username, password string, values url.Values) (*http.Response, []byte, error) {
+	req, err := newRequest(ctx, method, uri, username, password, values)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	res, err := c.httpClient.Do(req)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	defer res.Body.Close()
 
 	body, err := ioutil.ReadAll(res.Body)
+
+	return res, body, err
+}
+
+func newRequest(ctx context.Context, method, uri, username, password string, values url.Values) (*http.Request, error) {
+	var reqBody io.Reader
+	if values != nil {
+		reqBody = strings.NewReader(values.Encode())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, uri, reqBody)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if res.StatusCode != 204 {
-		if res.StatusCode == 500 {
-			return ErrTwilioServer
-		}
+	req.SetBasicAuth(username, password)
+	if values != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
 
-		twilioError := new(TwilioError)
-		err := json.Unmarshal(body, &twilioError)
-		if err != nil {
-			return err
-		}
+	return req, nil
+}
 
-		return twilioError
+func (c *TwilioAPIClient) credentials(ctx context.Context) (string, string, error) {
+	if c.Credentials == nil {
+		return c.AccountSid, c.AuthToken, nil
 	}
 
-	return nil
+	return c.Credentials.Credentials(ctx)
+}
+
+// apiRoot returns the root of the API, which RootURL is built upon.
+func (c *TwilioAPIClient) apiRoot() string {
+	if i := strings.Index(c.RootURL, "/"+VERSION+"/"); i >= 0 {
+		return c.RootURL[:i]
+	}
+
+	return strings.TrimSuffix(c.RootURL, "/")
 }
 
 func (c *TwilioAPIClient) buildURL(uri string, requestOptions []option.RequestOption) (string, error) {
@@ -171,11 +341,15 @@ func (c *TwilioAPIClient) buildURL(uri string, requestOptions []option.RequestOp
 	}
 
 	var urlStr string
+	switch {
 	// Check for "http" because sometimes we get raw URLs from following the metadata.
-	if !strings.HasPrefix(uri, "http") {
-		urlStr = c.RootURL + "/" + uri
-	} else {
+	case strings.HasPrefix(uri, "http"):
 		urlStr = uri
+	// URIs returned by Twilio, like the next page ones, are relative to the API root, not to the account.
+	case strings.HasPrefix(uri, VERSION+"/"):
+		urlStr = c.apiRoot() + "/" + uri
+	default:
+		urlStr = c.RootURL + "/" + uri
 	}
 
 	u, err := url.Parse(urlStr)
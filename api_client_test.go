@@ -1,8 +1,11 @@
 package twiliolo_test
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"net/http"
+	"net/url"
 	"strconv"
 	"testing"
 
@@ -95,6 +98,30 @@ func TestGet(t *testing.T) {
 		assert.Nil(t, body)
 	})
 
+	t.Run("Context GET", func(t *testing.T) {
+		type ctxKey string
+		ctx := context.WithValue(context.Background(), ctxKey("key"), "value")
+
+		httpMock := internal.HTTPMockClient{}
+		httpMock.DoFn = func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "GET", req.Method)
+			assert.Equal(t, "value", req.Context().Value(ctxKey("key")))
+
+			return &http.Response{
+				Status:     strconv.Itoa(200),
+				StatusCode: 200,
+				Body:       internal.NewRespBodyFromString("Success"),
+				Header:     http.Header{},
+			}, nil
+		}
+
+		client := twiliolo.NewTwilioAPIClient(ACCOUNT_SID, AUTH_TOKEN, &httpMock)
+		body, err := client.GetContext(ctx, "/TestGet", make([]option.RequestOption, 0))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("Success"), body)
+	})
+
 	t.Run("Error 500 GET", func(t *testing.T) {
 		httpMock := internal.HTTPMockClient{}
 		httpMock.DoFn = func(req *http.Request) (*http.Response, error) {
@@ -118,8 +145,16 @@ func TestGet(t *testing.T) {
 		body, err := client.Get("/TestGet", make([]option.RequestOption, 0))
 
 		assert.Error(t, err)
-		assert.Equal(t, twiliolo.ErrTwilioServer, err)
+		assert.True(t, errors.Is(err, twiliolo.ErrTwilioServer))
 		assert.Equal(t, []byte(""), body)
+
+		twilioError, ok := err.(*twiliolo.TwilioError)
+
+		assert.True(t, ok)
+		assert.Equal(t, 500, twilioError.HTTPStatus)
+		assert.Equal(t, "GET", twilioError.Method)
+		assert.Equal(t, ROOT_URL+"/TestGet", twilioError.URL)
+		assert.True(t, twilioError.Retryable())
 	})
 
 	t.Run("Error 403 GET", func(t *testing.T) {
@@ -187,14 +222,249 @@ func TestGet(t *testing.T) {
 
 		assert.Error(t, err)
 
-		_, ok := err.(*twiliolo.TwilioError)
+		twilioError, ok := err.(*twiliolo.TwilioError)
 
-		assert.Contains(t, err.Error(), "json: cannot unmarshal string")
-		assert.False(t, ok)
+		assert.True(t, ok)
+		assert.Equal(t, 403, twilioError.Status)
+		assert.Equal(t, 403, twilioError.HTTPStatus)
 		assert.NotNil(t, body)
 	})
 }
 
+func TestGetPageURI(t *testing.T) {
+	t.Run("OK - Resolved against the default root", func(t *testing.T) {
+		httpMock := internal.HTTPMockClient{}
+		httpMock.DoFn = func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, twiliolo.ROOT+"/2010-04-01/Accounts/FAKE/Messages.json?Page=1", req.URL.String())
+
+			return &http.Response{StatusCode: 200, Body: internal.NewRespBodyFromString("{}"), Header: http.Header{}}, nil
+		}
+
+		client := twiliolo.NewTwilioAPIClient(ACCOUNT_SID, AUTH_TOKEN, &httpMock)
+		_, err := client.Get("/2010-04-01/Accounts/FAKE/Messages.json?Page=1", nil)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("OK - Resolved against a custom root", func(t *testing.T) {
+		httpMock := internal.HTTPMockClient{}
+		httpMock.DoFn = func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "http://localhost:8080/2010-04-01/Accounts/FAKE/Messages.json?Page=1", req.URL.String())
+
+			return &http.Response{StatusCode: 200, Body: internal.NewRespBodyFromString("{}"), Header: http.Header{}}, nil
+		}
+
+		client := twiliolo.NewTwilioAPIClient(ACCOUNT_SID, AUTH_TOKEN, &httpMock)
+		client.RootURL = "http://localhost:8080/2010-04-01/Accounts/FAKE"
+		_, err := client.Get("/2010-04-01/Accounts/FAKE/Messages.json?Page=1", nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, httpMock.DoCall)
+	})
+}
+
+func TestAPIKeyAuthentication(t *testing.T) {
+	t.Run("OK - API Key used for auth, account Sid in URL", func(t *testing.T) {
+		httpMock := internal.HTTPMockClient{}
+		httpMock.DoFn = func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, ROOT_URL+"/TestGet", req.URL.String())
+
+			user, password, ok := req.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, "SKFake", user)
+			assert.Equal(t, "Secret", password)
+
+			return &http.Response{
+				Status:     strconv.Itoa(200),
+				StatusCode: 200,
+				Body:       internal.NewRespBodyFromString("Success"),
+				Header:     http.Header{},
+			}, nil
+		}
+
+		client := twiliolo.NewTwilioAPIClientWithAPIKey(ACCOUNT_SID, "SKFake", "Secret", &httpMock)
+		body, err := client.Get("/TestGet", nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("Success"), body)
+	})
+
+	t.Run("NOK - Credential provider failure", func(t *testing.T) {
+		httpMock := internal.HTTPMockClient{}
+		providerErr := errors.New("secret store unavailable")
+		provider := twiliolo.CredentialProviderFunc(func(context.Context) (string, string, error) {
+			return "", "", providerErr
+		})
+
+		client := twiliolo.NewTwilioAPIClientWithCredentials(ACCOUNT_SID, provider, &httpMock)
+		_, err := client.Get("/TestGet", nil)
+
+		assert.Equal(t, providerErr, err)
+		assert.Equal(t, 0, httpMock.DoCall)
+	})
+}
+
+func TestDownload(t *testing.T) {
+	t.Run("OK - Body streamed to the writer", func(t *testing.T) {
+		httpMock := internal.HTTPMockClient{}
+		httpMock.DoFn = func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "GET", req.Method)
+			assert.Equal(t, ROOT_URL+"/Recordings/RETwilioloFake.mp3", req.URL.String())
+
+			return newStatusResponse(200, "ID3 audio", http.Header{}), nil
+		}
+
+		client := twiliolo.NewTwilioAPIClient(ACCOUNT_SID, AUTH_TOKEN, &httpMock)
+
+		var buf bytes.Buffer
+		err := client.Download("/Recordings/RETwilioloFake.mp3", nil, &buf)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ID3 audio", buf.String())
+	})
+
+	t.Run("OK - Retried before anything is written", func(t *testing.T) {
+		httpMock := internal.HTTPMockClient{}
+		httpMock.DoFn = func(req *http.Request) (*http.Response, error) {
+			if httpMock.DoCall == 1 {
+				return newStatusResponse(503, "", http.Header{}), nil
+			}
+
+			return newStatusResponse(200, "RIFF audio", http.Header{}), nil
+		}
+
+		client := twiliolo.NewTwilioAPIClient(ACCOUNT_SID, AUTH_TOKEN, &httpMock)
+		client.RetryPolicy = testRetryPolicy()
+
+		var buf bytes.Buffer
+		err := client.Download("/Recordings/RETwilioloFake.wav", nil, &buf)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, httpMock.DoCall)
+		assert.Equal(t, "RIFF audio", buf.String())
+	})
+
+	t.Run("Error 404 Download", func(t *testing.T) {
+		httpMock := internal.HTTPMockClient{}
+		httpMock.DoFn = func(req *http.Request) (*http.Response, error) {
+			return newStatusResponse(404, `{"status": 404, "code": 20404, "message": "The requested resource was not found"}`, http.Header{}), nil
+		}
+
+		client := twiliolo.NewTwilioAPIClient(ACCOUNT_SID, AUTH_TOKEN, &httpMock)
+
+		var buf bytes.Buffer
+		err := client.Download("/Recordings/RETwilioloFake.wav", nil, &buf)
+
+		assert.True(t, twiliolo.IsNotFound(err))
+		assert.Equal(t, 0, buf.Len())
+	})
+}
+
+func TestPost(t *testing.T) {
+	t.Run("Basic POST", func(t *testing.T) {
+		httpMock := internal.HTTPMockClient{}
+		httpMock.DoFn = func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "POST", req.Method)
+			assert.Equal(t, ROOT_URL+"/TestPost", req.URL.String())
+			assert.Equal(t, "application/x-www-form-urlencoded", req.Header.Get("Content-Type"))
+			assert.NoError(t, req.ParseForm())
+			assert.Equal(t, "Value", req.PostForm.Get("Key"))
+
+			return &http.Response{
+				Status:     strconv.Itoa(201),
+				StatusCode: 201,
+				Body:       internal.NewRespBodyFromString("Success"),
+				Header:     http.Header{},
+			}, nil
+		}
+
+		client := twiliolo.NewTwilioAPIClient(ACCOUNT_SID, AUTH_TOKEN, &httpMock)
+		body, err := client.Post("/TestPost", make([]option.RequestOption, 0), url.Values{"Key": []string{"Value"}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("Success"), body)
+	})
+
+	t.Run("Error 400 POST", func(t *testing.T) {
+		httpMock := internal.HTTPMockClient{}
+		httpMock.DoFn = func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				Status:     strconv.Itoa(400),
+				StatusCode: 400,
+				Header:     http.Header{"Twilio-Request-Id": []string{"RQTwilioloFake"}},
+				Body: internal.NewRespBodyFromString(`{
+					"status": 400,
+					"message": "The 'To' number is not a valid phone number.",
+					"code": 21211,
+					"more_info": "https://www.twilio.com/docs/errors/21211"
+				}`),
+			}, nil
+		}
+
+		client := twiliolo.NewTwilioAPIClient(ACCOUNT_SID, AUTH_TOKEN, &httpMock)
+		body, err := client.Post("/TestPost", make([]option.RequestOption, 0), url.Values{})
+
+		assert.True(t, twiliolo.IsInvalidTo(err))
+		assert.False(t, twiliolo.IsRetryable(err))
+
+		twilioError, ok := err.(*twiliolo.TwilioError)
+
+		assert.True(t, ok)
+		assert.Equal(t, 400, twilioError.HTTPStatus)
+		assert.Equal(t, "POST", twilioError.Method)
+		assert.Equal(t, "RQTwilioloFake", twilioError.RequestID)
+		assert.Equal(t, body, twilioError.Body)
+	})
+
+	t.Run("Error 400 - Malformated JSON POST", func(t *testing.T) {
+		httpMock := internal.HTTPMockClient{}
+		httpMock.DoFn = func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				Status:     strconv.Itoa(400),
+				StatusCode: 400,
+				Header:     http.Header{},
+				Body:       internal.NewRespBodyFromString(`{"status": "malformated JSON"}`),
+			}, nil
+		}
+
+		client := twiliolo.NewTwilioAPIClient(ACCOUNT_SID, AUTH_TOKEN, &httpMock)
+		body, err := client.Post("/TestPost", make([]option.RequestOption, 0), url.Values{})
+
+		twilioError, ok := err.(*twiliolo.TwilioError)
+
+		assert.True(t, ok)
+		assert.Equal(t, 400, twilioError.Status)
+		assert.Equal(t, 400, twilioError.HTTPStatus)
+		assert.Equal(t, ROOT_URL+"/TestPost", twilioError.URL)
+		assert.Equal(t, body, twilioError.Body)
+	})
+
+	t.Run("Error 404 - Non JSON POST", func(t *testing.T) {
+		httpMock := internal.HTTPMockClient{}
+		httpMock.DoFn = func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				Status:     strconv.Itoa(404),
+				StatusCode: 404,
+				Header:     http.Header{"Twilio-Request-Id": []string{"RQTwilioloFake"}},
+				Body:       internal.NewRespBodyFromString(`<html>Not Found</html>`),
+			}, nil
+		}
+
+		client := twiliolo.NewTwilioAPIClient(ACCOUNT_SID, AUTH_TOKEN, &httpMock)
+		_, err := client.Post("/TestPost", make([]option.RequestOption, 0), url.Values{})
+
+		assert.True(t, twiliolo.IsNotFound(err))
+		assert.True(t, errors.Is(err, twiliolo.ErrNotFound))
+
+		twilioError, ok := err.(*twiliolo.TwilioError)
+
+		assert.True(t, ok)
+		assert.Equal(t, 404, twilioError.Status)
+		assert.Equal(t, "RQTwilioloFake", twilioError.RequestID)
+		assert.Equal(t, []byte(`<html>Not Found</html>`), twilioError.Body)
+	})
+}
+
 func TestDelete(t *testing.T) {
 	t.Run("Basic DELETE", func(t *testing.T) {
 		httpMock := internal.HTTPMockClient{}
@@ -272,7 +542,7 @@ func TestDelete(t *testing.T) {
 		err := client.Delete("/TestDelete", make([]option.RequestOption, 0))
 
 		assert.Error(t, err)
-		assert.Equal(t, twiliolo.ErrTwilioServer, err)
+		assert.True(t, errors.Is(err, twiliolo.ErrTwilioServer))
 	})
 
 	t.Run("Error 403 DELETE", func(t *testing.T) {
@@ -339,9 +609,10 @@ func TestDelete(t *testing.T) {
 
 		assert.Error(t, err)
 
-		_, ok := err.(*twiliolo.TwilioError)
+		twilioError, ok := err.(*twiliolo.TwilioError)
 
-		assert.Contains(t, err.Error(), "json: cannot unmarshal string")
-		assert.False(t, ok)
+		assert.True(t, ok)
+		assert.Equal(t, 403, twilioError.Status)
+		assert.Equal(t, 403, twilioError.HTTPStatus)
 	})
 }
@@ -0,0 +1,373 @@
+package twiliolo
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// ApplicationServiceInterface is the interface of an ApplicationService
+type ApplicationServiceInterface interface {
+	Create(*ApplicationParams, ...option.RequestOption) (*Application, error)
+	Get(string, ...option.RequestOption) (*Application, error)
+	Update(string, *ApplicationUpdate, ...option.RequestOption) (*Application, error)
+	Delete(string, ...option.RequestOption) error
+	List(...option.RequestOption) (*ApplicationList, error)
+	ListNextPage(*ApplicationList) (*ApplicationList, error)
+	Iter(...option.RequestOption) *Iterator[*Application]
+	AttachVoice(string, string, ...option.RequestOption) (*IncomingPhoneNumber, error)
+	AttachSMS(string, string, ...option.RequestOption) (*IncomingPhoneNumber, error)
+	FindNumbers(string, ...option.RequestOption) ([]*IncomingPhoneNumber, error)
+	CreateContext(context.Context, *ApplicationParams, ...option.RequestOption) (*Application, error)
+	GetContext(context.Context, string, ...option.RequestOption) (*Application, error)
+	UpdateContext(context.Context, string, *ApplicationUpdate, ...option.RequestOption) (*Application, error)
+	DeleteContext(context.Context, string, ...option.RequestOption) error
+	ListContext(context.Context, ...option.RequestOption) (*ApplicationList, error)
+	ListNextPageContext(context.Context, *ApplicationList) (*ApplicationList, error)
+	IterContext(context.Context, ...option.RequestOption) *Iterator[*Application]
+	AttachVoiceContext(context.Context, string, string, ...option.RequestOption) (*IncomingPhoneNumber, error)
+	AttachSMSContext(context.Context, string, string, ...option.RequestOption) (*IncomingPhoneNumber, error)
+	FindNumbersContext(context.Context, string, ...option.RequestOption) ([]*IncomingPhoneNumber, error)
+}
+
+// ApplicationService handles communication with the Application related methods.
+type ApplicationService service
+
+// Application represents a Twilio Application, a set of voice and SMS URLs
+// referenced by the VoiceApplicationSid and SmsApplicationSid of Incoming Phone Numbers.
+type Application struct {
+	Sid                   string    `json:"sid"`
+	AccountSid            string    `json:"account_sid"`
+	FriendlyName          string    `json:"friendly_name"`
+	APIVersion            string    `json:"api_version"`
+	VoiceURL              string    `json:"voice_url"`
+	VoiceMethod           string    `json:"voice_method"`
+	VoiceFallbackURL      string    `json:"voice_fallback_url"`
+	VoiceFallbackMethod   string    `json:"voice_fallback_method"`
+	StatusCallback        string    `json:"status_callback"`
+	StatusCallbackMethod  string    `json:"status_callback_method"`
+	VoiceCallerIDLookup   bool      `json:"voice_caller_id_lookup"`
+	SmsURL                string    `json:"sms_url"`
+	SmsMethod             string    `json:"sms_method"`
+	SmsFallbackURL        string    `json:"sms_fallback_url"`
+	SmsFallbackMethod     string    `json:"sms_fallback_method"`
+	SmsStatusCallback     string    `json:"sms_status_callback"`
+	MessageStatusCallback string    `json:"message_status_callback"`
+	DateCreated           time.Time `json:"date_created"`
+	DateUpdated           time.Time `json:"date_updated"`
+	URI                   string    `json:"uri"`
+}
+
+// UnmarshalJSON decodes an Application, parsing its RFC 2822 dates.
+func (a *Application) UnmarshalJSON(data []byte) error {
+	type application Application
+
+	raw := struct {
+		*application
+		DateCreated string `json:"date_created"`
+		DateUpdated string `json:"date_updated"`
+	}{application: (*application)(a)}
+
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	a.DateCreated, err = parseDate(raw.DateCreated)
+	if err != nil {
+		return err
+	}
+
+	a.DateUpdated, err = parseDate(raw.DateUpdated)
+
+	return err
+}
+
+// MarshalJSON encodes an Application, formatting its dates in RFC 2822 like Twilio.
+func (a Application) MarshalJSON() ([]byte, error) {
+	type application Application
+
+	return json.Marshal(struct {
+		application
+		DateCreated string `json:"date_created"`
+		DateUpdated string `json:"date_updated"`
+	}{
+		application: application(a),
+		DateCreated: formatDate(a.DateCreated),
+		DateUpdated: formatDate(a.DateUpdated),
+	})
+}
+
+// ApplicationParams contains the parameters used to create a new Application,
+// only the settings which are set are sent.
+type ApplicationParams struct {
+	FriendlyName          string
+	APIVersion            string
+	VoiceURL              string
+	VoiceMethod           string
+	VoiceFallbackURL      string
+	VoiceFallbackMethod   string
+	StatusCallback        string
+	StatusCallbackMethod  string
+	VoiceCallerIDLookup   bool
+	SmsURL                string
+	SmsMethod             string
+	SmsFallbackURL        string
+	SmsFallbackMethod     string
+	SmsStatusCallback     string
+	MessageStatusCallback string
+}
+
+func (p *ApplicationParams) values() url.Values {
+	values := url.Values{}
+
+	fields := []struct {
+		key   string
+		value string
+	}{
+		{"FriendlyName", p.FriendlyName},
+		{"ApiVersion", p.APIVersion},
+		{"VoiceUrl", p.VoiceURL},
+		{"VoiceMethod", p.VoiceMethod},
+		{"VoiceFallbackUrl", p.VoiceFallbackURL},
+		{"VoiceFallbackMethod", p.VoiceFallbackMethod},
+		{"StatusCallback", p.StatusCallback},
+		{"StatusCallbackMethod", p.StatusCallbackMethod},
+		{"SmsUrl", p.SmsURL},
+		{"SmsMethod", p.SmsMethod},
+		{"SmsFallbackUrl", p.SmsFallbackURL},
+		{"SmsFallbackMethod", p.SmsFallbackMethod},
+		{"SmsStatusCallback", p.SmsStatusCallback},
+		{"MessageStatusCallback", p.MessageStatusCallback},
+	}
+
+	for _, field := range fields {
+		if field.value != "" {
+			values.Set(field.key, field.value)
+		}
+	}
+
+	if p.VoiceCallerIDLookup {
+		values.Set("VoiceCallerIdLookup", "true")
+	}
+
+	return values
+}
+
+// ApplicationUpdate contains the fields to update on an Application.
+// A nil field is left untouched while a pointer to an empty value clears the field.
+type ApplicationUpdate struct {
+	FriendlyName          *string
+	APIVersion            *string
+	VoiceURL              *string
+	VoiceMethod           *string
+	VoiceFallbackURL      *string
+	VoiceFallbackMethod   *string
+	StatusCallback        *string
+	StatusCallbackMethod  *string
+	VoiceCallerIDLookup   *bool
+	SmsURL                *string
+	SmsMethod             *string
+	SmsFallbackURL        *string
+	SmsFallbackMethod     *string
+	SmsStatusCallback     *string
+	MessageStatusCallback *string
+}
+
+func (u *ApplicationUpdate) values() url.Values {
+	values := url.Values{}
+
+	fields := []struct {
+		key   string
+		value *string
+	}{
+		{"FriendlyName", u.FriendlyName},
+		{"ApiVersion", u.APIVersion},
+		{"VoiceUrl", u.VoiceURL},
+		{"VoiceMethod", u.VoiceMethod},
+		{"VoiceFallbackUrl", u.VoiceFallbackURL},
+		{"VoiceFallbackMethod", u.VoiceFallbackMethod},
+		{"StatusCallback", u.StatusCallback},
+		{"StatusCallbackMethod", u.StatusCallbackMethod},
+		{"SmsUrl", u.SmsURL},
+		{"SmsMethod", u.SmsMethod},
+		{"SmsFallbackUrl", u.SmsFallbackURL},
+		{"SmsFallbackMethod", u.SmsFallbackMethod},
+		{"SmsStatusCallback", u.SmsStatusCallback},
+		{"MessageStatusCallback", u.MessageStatusCallback},
+	}
+
+	for _, field := range fields {
+		if field.value != nil {
+			values.Set(field.key, *field.value)
+		}
+	}
+
+	if u.VoiceCallerIDLookup != nil {
+		values.Set("VoiceCallerIdLookup", strconv.FormatBool(*u.VoiceCallerIDLookup))
+	}
+
+	return values
+}
+
+// Create creates a new Application.
+// Doc: https://www.twilio.com/docs/usage/api/applications#create-an-application-resource
+func (s *ApplicationService) Create(params *ApplicationParams, requestOptions ...option.RequestOption) (*Application, error) {
+	return s.CreateContext(context.Background(), params, requestOptions...)
+}
+
+// CreateContext performs the same call as Create, bound to the given context.
+func (s *ApplicationService) CreateContext(ctx context.Context, params *ApplicationParams, requestOptions ...option.RequestOption) (*Application, error) {
+	if params == nil {
+		return nil, ErrApplicationMissingData
+	}
+
+	return s.post(ctx, "/Applications.json", requestOptions, params.values())
+}
+
+// Get performs a call to the twilio API to retrieve an Application with its Sid.
+// Doc: https://www.twilio.com/docs/usage/api/applications#fetch-an-application-resource
+func (s *ApplicationService) Get(sid string, requestOptions ...option.RequestOption) (*Application, error) {
+	return s.GetContext(context.Background(), sid, requestOptions...)
+}
+
+// GetContext performs the same call as Get, bound to the given context.
+func (s *ApplicationService) GetContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*Application, error) {
+	if sid == "" {
+		return nil, ErrApplicationMissingData
+	}
+
+	res, err := s.Client.GetContext(ctx, "/Applications/"+sid+".json", requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	application := new(Application)
+	err = json.Unmarshal(res, application)
+
+	return application, err
+}
+
+// Update performs a partial update of an Application, only the fields set in the ApplicationUpdate are sent.
+// Doc: https://www.twilio.com/docs/usage/api/applications#update-an-application-resource
+func (s *ApplicationService) Update(sid string, update *ApplicationUpdate, requestOptions ...option.RequestOption) (*Application, error) {
+	return s.UpdateContext(context.Background(), sid, update, requestOptions...)
+}
+
+// UpdateContext performs the same call as Update, bound to the given context.
+func (s *ApplicationService) UpdateContext(ctx context.Context, sid string, update *ApplicationUpdate, requestOptions ...option.RequestOption) (*Application, error) {
+	if sid == "" || update == nil {
+		return nil, ErrApplicationMissingData
+	}
+
+	return s.post(ctx, "/Applications/"+sid+".json", requestOptions, update.values())
+}
+
+// Delete removes an Application.
+// Doc: https://www.twilio.com/docs/usage/api/applications#delete-an-application-resource
+func (s *ApplicationService) Delete(sid string, requestOptions ...option.RequestOption) error {
+	return s.DeleteContext(context.Background(), sid, requestOptions...)
+}
+
+// DeleteContext performs the same call as Delete, bound to the given context.
+func (s *ApplicationService) DeleteContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) error {
+	if sid == "" {
+		return ErrApplicationMissingData
+	}
+
+	return s.Client.DeleteContext(ctx, "/Applications/"+sid+".json", requestOptions)
+}
+
+// AttachVoice sets the Application as the VoiceApplicationSid of an Incoming Phone Number,
+// its voice calls are then handled by the Application voice URLs.
+func (s *ApplicationService) AttachVoice(sid, incomingPhoneNumberSid string, requestOptions ...option.RequestOption) (*IncomingPhoneNumber, error) {
+	return s.AttachVoiceContext(context.Background(), sid, incomingPhoneNumberSid, requestOptions...)
+}
+
+// AttachVoiceContext performs the same call as AttachVoice, bound to the given context.
+func (s *ApplicationService) AttachVoiceContext(ctx context.Context, sid, incomingPhoneNumberSid string, requestOptions ...option.RequestOption) (*IncomingPhoneNumber, error) {
+	if sid == "" {
+		return nil, ErrApplicationMissingData
+	}
+
+	update := &IncomingPhoneNumberUpdate{VoiceApplicationSid: String(sid)}
+
+	return (*IncomingPhoneNumberService)(s).UpdateContext(ctx, incomingPhoneNumberSid, update, requestOptions...)
+}
+
+// AttachSMS sets the Application as the SmsApplicationSid of an Incoming Phone Number,
+// its messages are then handled by the Application SMS URLs.
+func (s *ApplicationService) AttachSMS(sid, incomingPhoneNumberSid string, requestOptions ...option.RequestOption) (*IncomingPhoneNumber, error) {
+	return s.AttachSMSContext(context.Background(), sid, incomingPhoneNumberSid, requestOptions...)
+}
+
+// AttachSMSContext performs the same call as AttachSMS, bound to the given context.
+func (s *ApplicationService) AttachSMSContext(ctx context.Context, sid, incomingPhoneNumberSid string, requestOptions ...option.RequestOption) (*IncomingPhoneNumber, error) {
+	if sid == "" {
+		return nil, ErrApplicationMissingData
+	}
+
+	update := &IncomingPhoneNumberUpdate{SmsApplicationSid: String(sid)}
+
+	return (*IncomingPhoneNumberService)(s).UpdateContext(ctx, incomingPhoneNumberSid, update, requestOptions...)
+}
+
+// FindNumbers retrieves all the Incoming Phone Numbers of your account whose
+// VoiceApplicationSid or SmsApplicationSid is the given Application.
+// The numbers are listed 200 per page unless an option.PageSize is given.
+func (s *ApplicationService) FindNumbers(sid string, requestOptions ...option.RequestOption) ([]*IncomingPhoneNumber, error) {
+	return s.FindNumbersContext(context.Background(), sid, requestOptions...)
+}
+
+// FindNumbersContext performs the same calls as FindNumbers, bound to the given context.
+func (s *ApplicationService) FindNumbersContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) ([]*IncomingPhoneNumber, error) {
+	if sid == "" {
+		return nil, ErrApplicationMissingData
+	}
+
+	newRequestOptions := []option.RequestOption{option.PageSize(200)}
+	for _, requestOption := range requestOptions {
+		switch requestOption.(type) {
+		case option.PageSize:
+			newRequestOptions[0] = requestOption
+		default:
+			newRequestOptions = append(newRequestOptions, requestOption)
+		}
+	}
+
+	phones := make([]*IncomingPhoneNumber, 0)
+
+	it := (*IncomingPhoneNumberService)(s).IterContext(ctx, newRequestOptions...)
+	for it.Next() {
+		phone := it.Value()
+		if phone.VoiceApplicationSid == sid || phone.SmsApplicationSid == sid {
+			phones = append(phones, phone)
+		}
+	}
+
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return phones, nil
+}
+
+func (s *ApplicationService) post(ctx context.Context, uri string, requestOptions []option.RequestOption, values url.Values) (*Application, error) {
+	body, err := s.Client.PostContext(ctx, uri, requestOptions, values)
+	if err != nil {
+		return nil, err
+	}
+
+	var application Application
+
+	err = json.Unmarshal(body, &application)
+	if err != nil {
+		return nil, err
+	}
+
+	return &application, nil
+}
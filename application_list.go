@@ -0,0 +1,76 @@
+package twiliolo
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// ApplicationList represents the response of the Twilio API when calling /Applications.json
+type ApplicationList struct {
+	Page            int            `json:"page"`
+	PageSize        int            `json:"page_size"`
+	URI             string         `json:"uri"`
+	FirstPageURI    string         `json:"first_page_uri"`
+	NextPageURI     string         `json:"next_page_uri"`
+	PreviousPageURI string         `json:"previous_page_uri"`
+	Applications    []*Application `json:"applications"`
+}
+
+// List retrieves the first page of the Applications of the account, filtered with the FriendlyName option.
+// Doc: https://www.twilio.com/docs/usage/api/applications#read-multiple-application-resources
+func (s *ApplicationService) List(requestOptions ...option.RequestOption) (*ApplicationList, error) {
+	return s.ListContext(context.Background(), requestOptions...)
+}
+
+// ListContext performs the same call as List, bound to the given context.
+func (s *ApplicationService) ListContext(ctx context.Context, requestOptions ...option.RequestOption) (*ApplicationList, error) {
+	body, err := s.Client.GetContext(ctx, "/Applications.json", requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationList := new(ApplicationList)
+	err = json.Unmarshal(body, applicationList)
+
+	return applicationList, err
+}
+
+// ListNextPage retrieves the next page of a given ApplicationList by following its NextPageURI.
+// If an empty NextPageURI is present in the struct it'll return an error
+func (s *ApplicationService) ListNextPage(previousList *ApplicationList) (*ApplicationList, error) {
+	return s.ListNextPageContext(context.Background(), previousList)
+}
+
+// ListNextPageContext performs the same call as ListNextPage, bound to the given context.
+func (s *ApplicationService) ListNextPageContext(ctx context.Context, previousList *ApplicationList) (*ApplicationList, error) {
+	if previousList == nil || previousList.NextPageURI == "" {
+		return nil, ErrNoNextPage
+	}
+
+	body, err := s.Client.GetContext(ctx, previousList.NextPageURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationList := new(ApplicationList)
+	err = json.Unmarshal(body, applicationList)
+
+	return applicationList, err
+}
+
+// Iter returns an Iterator over all the Applications of the account, filtered with the FriendlyName option.
+func (s *ApplicationService) Iter(requestOptions ...option.RequestOption) *Iterator[*Application] {
+	return s.IterContext(context.Background(), requestOptions...)
+}
+
+// IterContext performs the same calls as Iter, bound to the given context.
+func (s *ApplicationService) IterContext(ctx context.Context, requestOptions ...option.RequestOption) *Iterator[*Application] {
+	return newIterator(ctx, s.Client, "/Applications.json", requestOptions, func(body []byte) ([]*Application, string, error) {
+		list := new(ApplicationList)
+		err := json.Unmarshal(body, list)
+
+		return list.Applications, list.NextPageURI, err
+	})
+}
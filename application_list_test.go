@@ -0,0 +1,59 @@
+package twiliolo_test
+
+import (
+	"testing"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplicationList(t *testing.T) {
+	client := new(internal.MockAPIClient)
+	client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+		assert.Equal(t, "/Applications.json", uri)
+		assert.Equal(t, []option.RequestOption{option.FriendlyName("IVR")}, requestOptions)
+
+		return []byte(`
+		{
+			"page": 0,
+			"page_size": 20,
+			"applications": [{"sid": "APTwilioloFake", "friendly_name": "IVR"}, {"sid": "APTwilioloFake2", "friendly_name": "IVR"}]
+		}`), nil
+	}
+
+	service := twiliolo.ApplicationService{Client: client}
+	list, err := service.List(option.FriendlyName("IVR"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(list.Applications))
+	assert.Equal(t, "APTwilioloFake2", list.Applications[1].Sid)
+}
+
+func TestApplicationListNextPage(t *testing.T) {
+	t.Run("OK - Follow next page URI", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/2010-04-01/Accounts/TwilioloFake/Applications.json?Page=1", uri)
+			assert.Empty(t, requestOptions)
+
+			return []byte(`{"page": 1, "applications": [{"sid": "APTwilioloFake3"}]}`), nil
+		}
+
+		service := twiliolo.ApplicationService{Client: client}
+		list, err := service.ListNextPage(&twiliolo.ApplicationList{NextPageURI: "/2010-04-01/Accounts/TwilioloFake/Applications.json?Page=1"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "APTwilioloFake3", list.Applications[0].Sid)
+	})
+
+	t.Run("NOK - No next page", func(t *testing.T) {
+		service := twiliolo.ApplicationService{Client: new(internal.MockAPIClient)}
+
+		list, err := service.ListNextPage(&twiliolo.ApplicationList{})
+
+		assert.Equal(t, twiliolo.ErrNoNextPage, err)
+		assert.Nil(t, list)
+	})
+}
@@ -0,0 +1,271 @@
+package twiliolo_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+const testApplicationResponse = `
+{
+	"sid": "APTwilioloFake",
+	"account_sid": "TwilioloFake",
+	"friendly_name": "IVR",
+	"api_version": "2010-04-01",
+	"voice_url": "https://example.com/voice.xml",
+	"voice_method": "POST",
+	"voice_caller_id_lookup": true,
+	"sms_url": "https://example.com/sms.xml",
+	"sms_method": "POST",
+	"date_created": "Mon, 16 Aug 2010 03:45:01 +0000",
+	"date_updated": "Mon, 16 Aug 2010 03:45:01 +0000",
+	"uri": "\/2010-04-01\/Accounts\/TwilioloFake\/Applications\/APTwilioloFake.json"
+}`
+
+func TestApplicationCreate(t *testing.T) {
+	t.Run("OK - Application created", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.PostFn = func(uri string, _ []option.RequestOption, values url.Values) ([]byte, error) {
+			assert.Equal(t, "/Applications.json", uri)
+			assert.Equal(t, url.Values{
+				"FriendlyName":        {"IVR"},
+				"VoiceUrl":            {"https://example.com/voice.xml"},
+				"VoiceCallerIdLookup": {"true"},
+			}, values)
+
+			return []byte(testApplicationResponse), nil
+		}
+
+		service := twiliolo.ApplicationService{Client: client}
+		application, err := service.Create(&twiliolo.ApplicationParams{
+			FriendlyName:        "IVR",
+			VoiceURL:            "https://example.com/voice.xml",
+			VoiceCallerIDLookup: true,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "APTwilioloFake", application.Sid)
+		assert.Equal(t, "https://example.com/voice.xml", application.VoiceURL)
+		assert.Equal(t, time.Date(2010, time.August, 16, 3, 45, 1, 0, time.UTC), application.DateCreated)
+		assert.Equal(t, time.Date(2010, time.August, 16, 3, 45, 1, 0, time.UTC), application.DateUpdated)
+		assert.True(t, application.VoiceCallerIDLookup)
+	})
+
+	t.Run("NOK - Missing params", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.ApplicationService{Client: client}
+
+		application, err := service.Create(nil)
+
+		assert.Equal(t, twiliolo.ErrApplicationMissingData, err)
+		assert.Nil(t, application)
+		assert.Equal(t, 0, client.PostCall)
+	})
+}
+
+func TestApplicationGet(t *testing.T) {
+	t.Run("OK - Application retrieved", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/Applications/APTwilioloFake.json", uri)
+
+			return []byte(testApplicationResponse), nil
+		}
+
+		service := twiliolo.ApplicationService{Client: client}
+		application, err := service.Get("APTwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "IVR", application.FriendlyName)
+		assert.Equal(t, "https://example.com/sms.xml", application.SmsURL)
+	})
+
+	t.Run("NOK - Missing sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.ApplicationService{Client: client}
+
+		application, err := service.Get("")
+
+		assert.Equal(t, twiliolo.ErrApplicationMissingData, err)
+		assert.Nil(t, application)
+	})
+}
+
+func TestApplicationUpdate(t *testing.T) {
+	t.Run("OK - Only set fields are sent", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.PostFn = func(uri string, _ []option.RequestOption, values url.Values) ([]byte, error) {
+			assert.Equal(t, "/Applications/APTwilioloFake.json", uri)
+			assert.Equal(t, url.Values{"SmsUrl": {""}, "VoiceCallerIdLookup": {"false"}}, values)
+
+			return []byte(testApplicationResponse), nil
+		}
+
+		service := twiliolo.ApplicationService{Client: client}
+		_, err := service.Update("APTwilioloFake", &twiliolo.ApplicationUpdate{
+			SmsURL:              twiliolo.String(""),
+			VoiceCallerIDLookup: twiliolo.Bool(false),
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, client.PostCall)
+	})
+
+	t.Run("NOK - Missing update", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.ApplicationService{Client: client}
+
+		application, err := service.Update("APTwilioloFake", nil)
+
+		assert.Equal(t, twiliolo.ErrApplicationMissingData, err)
+		assert.Nil(t, application)
+	})
+}
+
+func TestApplicationDelete(t *testing.T) {
+	t.Run("OK - Application deleted", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.DeleteFn = func(uri string, _ []option.RequestOption) error {
+			assert.Equal(t, "/Applications/APTwilioloFake.json", uri)
+
+			return nil
+		}
+
+		service := twiliolo.ApplicationService{Client: client}
+		err := service.Delete("APTwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, client.DeleteCall)
+	})
+
+	t.Run("NOK - Missing sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.ApplicationService{Client: client}
+
+		err := service.Delete("")
+
+		assert.Equal(t, twiliolo.ErrApplicationMissingData, err)
+		assert.Equal(t, 0, client.DeleteCall)
+	})
+}
+
+func TestApplicationAttach(t *testing.T) {
+	t.Run("OK - Attached for voice", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.PostFn = func(uri string, _ []option.RequestOption, values url.Values) ([]byte, error) {
+			assert.Equal(t, "/IncomingPhoneNumbers/PNTwilioloFake.json", uri)
+			assert.Equal(t, url.Values{"VoiceApplicationSid": {"APTwilioloFake"}}, values)
+
+			return []byte(`{"sid": "PNTwilioloFake", "voice_application_sid": "APTwilioloFake"}`), nil
+		}
+
+		service := twiliolo.ApplicationService{Client: client}
+		number, err := service.AttachVoice("APTwilioloFake", "PNTwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "APTwilioloFake", number.VoiceApplicationSid)
+	})
+
+	t.Run("OK - Attached for SMS", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.PostFn = func(uri string, _ []option.RequestOption, values url.Values) ([]byte, error) {
+			assert.Equal(t, "/IncomingPhoneNumbers/PNTwilioloFake.json", uri)
+			assert.Equal(t, url.Values{"SmsApplicationSid": {"APTwilioloFake"}}, values)
+
+			return []byte(`{"sid": "PNTwilioloFake", "sms_application_sid": "APTwilioloFake"}`), nil
+		}
+
+		service := twiliolo.ApplicationService{Client: client}
+		number, err := service.AttachSMS("APTwilioloFake", "PNTwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "APTwilioloFake", number.SmsApplicationSid)
+	})
+
+	t.Run("NOK - Missing application sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.ApplicationService{Client: client}
+
+		number, err := service.AttachVoice("", "PNTwilioloFake")
+
+		assert.Equal(t, twiliolo.ErrApplicationMissingData, err)
+		assert.Nil(t, number)
+		assert.Equal(t, 0, client.PostCall)
+	})
+
+	t.Run("NOK - Missing number sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.ApplicationService{Client: client}
+
+		number, err := service.AttachSMS("APTwilioloFake", "")
+
+		assert.Equal(t, twiliolo.ErrIncomingPhoneMissingData, err)
+		assert.Nil(t, number)
+	})
+}
+
+func TestApplicationFindNumbers(t *testing.T) {
+	t.Run("OK - Numbers using the application", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+			if client.GetCall == 1 {
+				assert.Equal(t, "/IncomingPhoneNumbers.json", uri)
+				assert.Equal(t, []option.RequestOption{option.PageSize(200)}, requestOptions)
+
+				return []byte(`
+				{
+					"next_page_uri": "\/2010-04-01\/Accounts\/TwilioloFake\/IncomingPhoneNumbers.json?Page=1",
+					"incoming_phone_numbers": [
+						{"sid": "PNTwilioloFake", "voice_application_sid": "APTwilioloFake"},
+						{"sid": "PNTwilioloFake2", "voice_application_sid": "APTwilioloFake2"}
+					]
+				}`), nil
+			}
+
+			assert.Equal(t, "/2010-04-01/Accounts/TwilioloFake/IncomingPhoneNumbers.json?Page=1", uri)
+
+			return []byte(`{"incoming_phone_numbers": [{"sid": "PNTwilioloFake3", "sms_application_sid": "APTwilioloFake"}]}`), nil
+		}
+
+		service := twiliolo.ApplicationService{Client: client}
+		numbers, err := service.FindNumbers("APTwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(numbers))
+		assert.Equal(t, "PNTwilioloFake", numbers[0].Sid)
+		assert.Equal(t, "PNTwilioloFake3", numbers[1].Sid)
+	})
+
+	t.Run("OK - Options merged with the default page size", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/IncomingPhoneNumbers.json", uri)
+			assert.Equal(t, []option.RequestOption{option.PageSize(50), option.FriendlyName("Support")}, requestOptions)
+
+			return []byte(`{"incoming_phone_numbers": [{"sid": "PNTwilioloFake", "sms_application_sid": "APTwilioloFake"}]}`), nil
+		}
+
+		service := twiliolo.ApplicationService{Client: client}
+		numbers, err := service.FindNumbers("APTwilioloFake", option.FriendlyName("Support"), option.PageSize(50))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, client.GetCall)
+		assert.Equal(t, 1, len(numbers))
+	})
+
+	t.Run("NOK - Missing sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.ApplicationService{Client: client}
+
+		numbers, err := service.FindNumbers("")
+
+		assert.Equal(t, twiliolo.ErrApplicationMissingData, err)
+		assert.Nil(t, numbers)
+		assert.Equal(t, 0, client.GetCall)
+	})
+}
@@ -1,8 +1,8 @@
 package twiliolo
 
 import (
+	"context"
 	"encoding/json"
-	"net/url"
 
 	"github.com/genesor/twiliolo/option"
 )
@@ -10,7 +10,27 @@ import (
 // AvailablePhoneNumberServiceInterface is the interface of a IncomingPhoneNumberService
 type AvailablePhoneNumberServiceInterface interface {
 	Local(string, ...option.RequestOption) ([]AvailablePhoneNumber, error)
+	TollFree(string, ...option.RequestOption) ([]AvailablePhoneNumber, error)
+	Mobile(string, ...option.RequestOption) ([]AvailablePhoneNumber, error)
+	National(string, ...option.RequestOption) ([]AvailablePhoneNumber, error)
+	SharedCost(string, ...option.RequestOption) ([]AvailablePhoneNumber, error)
+	Voip(string, ...option.RequestOption) ([]AvailablePhoneNumber, error)
+	MachineToMachine(string, ...option.RequestOption) ([]AvailablePhoneNumber, error)
+	Search(string, NumberType, ...option.RequestOption) ([]AvailablePhoneNumber, error)
+	Countries(...option.RequestOption) ([]AvailablePhoneNumberCountry, error)
 	Buy(*AvailablePhoneNumber, ...option.RequestOption) (*IncomingPhoneNumber, error)
+	Provision([]SearchCriterion, *IncomingPhoneNumberParams, ...option.RequestOption) (*ProvisionResult, error)
+	LocalContext(context.Context, string, ...option.RequestOption) ([]AvailablePhoneNumber, error)
+	TollFreeContext(context.Context, string, ...option.RequestOption) ([]AvailablePhoneNumber, error)
+	MobileContext(context.Context, string, ...option.RequestOption) ([]AvailablePhoneNumber, error)
+	NationalContext(context.Context, string, ...option.RequestOption) ([]AvailablePhoneNumber, error)
+	SharedCostContext(context.Context, string, ...option.RequestOption) ([]AvailablePhoneNumber, error)
+	VoipContext(context.Context, string, ...option.RequestOption) ([]AvailablePhoneNumber, error)
+	MachineToMachineContext(context.Context, string, ...option.RequestOption) ([]AvailablePhoneNumber, error)
+	SearchContext(context.Context, string, NumberType, ...option.RequestOption) ([]AvailablePhoneNumber, error)
+	CountriesContext(context.Context, ...option.RequestOption) ([]AvailablePhoneNumberCountry, error)
+	BuyContext(context.Context, *AvailablePhoneNumber, ...option.RequestOption) (*IncomingPhoneNumber, error)
+	ProvisionContext(context.Context, []SearchCriterion, *IncomingPhoneNumberParams, ...option.RequestOption) (*ProvisionResult, error)
 }
 
 // AvailablePhoneNumberService handles communication with the Incoming Phone Number related methods.
@@ -18,12 +38,12 @@ type AvailablePhoneNumberService service
 
 // AvailablePhoneNumber represents a Twilio Incoming Phone Number.
 type AvailablePhoneNumber struct {
-	FriendlyName        string       `json:"friendly_name"`
-	PhoneNumber         string       `json:"phone_number"`
-	ISOCountry          string       `json:"iso_country"`
-	AddressRequirements string       `json:"address_requirements"`
-	Capabilities        Capabilities `json:"capabilities"`
-	Beta                bool         `json:"beta"`
+	FriendlyName        string             `json:"friendly_name"`
+	PhoneNumber         string             `json:"phone_number"`
+	ISOCountry          string             `json:"iso_country"`
+	AddressRequirements AddressRequirement `json:"address_requirements"`
+	Capabilities        Capabilities       `json:"capabilities"`
+	Beta                bool               `json:"beta"`
 	// US & CA Only
 	Lata       string `json:"lata"`
 	RateCenter string `json:"rate_center"`
@@ -33,6 +53,55 @@ type AvailablePhoneNumber struct {
 	PostalCode string `json:"postal_code"`
 }
 
+// NumberType is the type of a phone number, as used in the available phone number searches.
+type NumberType string
+
+// Possible values of a NumberType.
+const (
+	NumberTypeLocal            NumberType = "Local"
+	NumberTypeTollFree         NumberType = "TollFree"
+	NumberTypeMobile           NumberType = "Mobile"
+	NumberTypeNational         NumberType = "National"
+	NumberTypeSharedCost       NumberType = "SharedCost"
+	NumberTypeVoip             NumberType = "Voip"
+	NumberTypeMachineToMachine NumberType = "MachineToMachine"
+)
+
+// numberTypeSubresources lists the subresource keys of an AvailablePhoneNumberCountry with their NumberType.
+var numberTypeSubresources = []struct {
+	key        string
+	numberType NumberType
+}{
+	{"local", NumberTypeLocal},
+	{"toll_free", NumberTypeTollFree},
+	{"mobile", NumberTypeMobile},
+	{"national", NumberTypeNational},
+	{"shared_cost", NumberTypeSharedCost},
+	{"voip", NumberTypeVoip},
+	{"machine_to_machine", NumberTypeMachineToMachine},
+}
+
+// AvailablePhoneNumberCountry represents a country where phone numbers are available.
+type AvailablePhoneNumberCountry struct {
+	CountryCode     string            `json:"country_code"`
+	Country         string            `json:"country"`
+	Beta            bool              `json:"beta"`
+	URI             string            `json:"uri"`
+	SubresourceURIs map[string]string `json:"subresource_uris"`
+}
+
+// NumberTypes returns the types of phone numbers available in the country.
+func (c *AvailablePhoneNumberCountry) NumberTypes() []NumberType {
+	numberTypes := make([]NumberType, 0, len(c.SubresourceURIs))
+	for _, subresource := range numberTypeSubresources {
+		if _, ok := c.SubresourceURIs[subresource.key]; ok {
+			numberTypes = append(numberTypes, subresource.numberType)
+		}
+	}
+
+	return numberTypes
+}
+
 type searchAvailablePhoneNumber struct {
 	AvailablePhoneNumbers []AvailablePhoneNumber `json:"available_phone_numbers"`
 	URI                   string                 `json:"uri"`
@@ -42,9 +111,104 @@ type searchAvailablePhoneNumber struct {
 // available with the given params
 // Doc: https://www.twilio.com/docs/api/rest/available-phone-numbers#local-instance
 func (s *AvailablePhoneNumberService) Local(countryCode string, requestOptions ...option.RequestOption) ([]AvailablePhoneNumber, error) {
+	return s.LocalContext(context.Background(), countryCode, requestOptions...)
+}
+
+// LocalContext performs the same call as Local, bound to the given context.
+func (s *AvailablePhoneNumberService) LocalContext(ctx context.Context, countryCode string, requestOptions ...option.RequestOption) ([]AvailablePhoneNumber, error) {
+	return s.SearchContext(ctx, countryCode, NumberTypeLocal, requestOptions...)
+}
+
+// TollFree performs a call to the twilio API to retrieve toll free phone numbers
+// available with the given params
+// Doc: https://www.twilio.com/docs/phone-numbers/api/availablephonenumbertollfree-resource
+func (s *AvailablePhoneNumberService) TollFree(countryCode string, requestOptions ...option.RequestOption) ([]AvailablePhoneNumber, error) {
+	return s.TollFreeContext(context.Background(), countryCode, requestOptions...)
+}
+
+// TollFreeContext performs the same call as TollFree, bound to the given context.
+func (s *AvailablePhoneNumberService) TollFreeContext(ctx context.Context, countryCode string, requestOptions ...option.RequestOption) ([]AvailablePhoneNumber, error) {
+	return s.SearchContext(ctx, countryCode, NumberTypeTollFree, requestOptions...)
+}
+
+// Mobile performs a call to the twilio API to retrieve mobile phone numbers
+// available with the given params
+// Doc: https://www.twilio.com/docs/phone-numbers/api/availablephonenumbermobile-resource
+func (s *AvailablePhoneNumberService) Mobile(countryCode string, requestOptions ...option.RequestOption) ([]AvailablePhoneNumber, error) {
+	return s.MobileContext(context.Background(), countryCode, requestOptions...)
+}
+
+// MobileContext performs the same call as Mobile, bound to the given context.
+func (s *AvailablePhoneNumberService) MobileContext(ctx context.Context, countryCode string, requestOptions ...option.RequestOption) ([]AvailablePhoneNumber, error) {
+	return s.SearchContext(ctx, countryCode, NumberTypeMobile, requestOptions...)
+}
+
+// National performs a call to the twilio API to retrieve national phone numbers
+// available with the given params
+// Doc: https://www.twilio.com/docs/phone-numbers/api/availablephonenumbernational-resource
+func (s *AvailablePhoneNumberService) National(countryCode string, requestOptions ...option.RequestOption) ([]AvailablePhoneNumber, error) {
+	return s.NationalContext(context.Background(), countryCode, requestOptions...)
+}
+
+// NationalContext performs the same call as National, bound to the given context.
+func (s *AvailablePhoneNumberService) NationalContext(ctx context.Context, countryCode string, requestOptions ...option.RequestOption) ([]AvailablePhoneNumber, error) {
+	return s.SearchContext(ctx, countryCode, NumberTypeNational, requestOptions...)
+}
+
+// SharedCost performs a call to the twilio API to retrieve shared cost phone numbers
+// available with the given params
+// Doc: https://www.twilio.com/docs/phone-numbers/api/availablephonenumbersharedcost-resource
+func (s *AvailablePhoneNumberService) SharedCost(countryCode string, requestOptions ...option.RequestOption) ([]AvailablePhoneNumber, error) {
+	return s.SharedCostContext(context.Background(), countryCode, requestOptions...)
+}
+
+// SharedCostContext performs the same call as SharedCost, bound to the given context.
+func (s *AvailablePhoneNumberService) SharedCostContext(ctx context.Context, countryCode string, requestOptions ...option.RequestOption) ([]AvailablePhoneNumber, error) {
+	return s.SearchContext(ctx, countryCode, NumberTypeSharedCost, requestOptions...)
+}
+
+// Voip performs a call to the twilio API to retrieve VoIP phone numbers
+// available with the given params
+// Doc: https://www.twilio.com/docs/phone-numbers/api/availablephonenumbervoip-resource
+func (s *AvailablePhoneNumberService) Voip(countryCode string, requestOptions ...option.RequestOption) ([]AvailablePhoneNumber, error) {
+	return s.VoipContext(context.Background(), countryCode, requestOptions...)
+}
+
+// VoipContext performs the same call as Voip, bound to the given context.
+func (s *AvailablePhoneNumberService) VoipContext(ctx context.Context, countryCode string, requestOptions ...option.RequestOption) ([]AvailablePhoneNumber, error) {
+	return s.SearchContext(ctx, countryCode, NumberTypeVoip, requestOptions...)
+}
+
+// MachineToMachine performs a call to the twilio API to retrieve machine to machine phone numbers
+// available with the given params
+// Doc: https://www.twilio.com/docs/phone-numbers/api/availablephonenumbermachinetomachine-resource
+func (s *AvailablePhoneNumberService) MachineToMachine(countryCode string, requestOptions ...option.RequestOption) ([]AvailablePhoneNumber, error) {
+	return s.MachineToMachineContext(context.Background(), countryCode, requestOptions...)
+}
+
+// MachineToMachineContext performs the same call as MachineToMachine, bound to the given context.
+func (s *AvailablePhoneNumberService) MachineToMachineContext(ctx context.Context, countryCode string, requestOptions ...option.RequestOption) ([]AvailablePhoneNumber, error) {
+	return s.SearchContext(ctx, countryCode, NumberTypeMachineToMachine, requestOptions...)
+}
+
+// Search performs a call to the twilio API to retrieve phone numbers of the
+// given type available with the given params.
+// The options are validated against the country and number type first,
+// returning an error wrapping ErrInvalidSearchOption when they can't be combined.
+func (s *AvailablePhoneNumberService) Search(countryCode string, numberType NumberType, requestOptions ...option.RequestOption) ([]AvailablePhoneNumber, error) {
+	return s.SearchContext(context.Background(), countryCode, numberType, requestOptions...)
+}
+
+// SearchContext performs the same call as Search, bound to the given context.
+func (s *AvailablePhoneNumberService) SearchContext(ctx context.Context, countryCode string, numberType NumberType, requestOptions ...option.RequestOption) ([]AvailablePhoneNumber, error) {
+	err := validateSearchOptions(countryCode, numberType, requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
 	var search searchAvailablePhoneNumber
 
-	res, err := s.Client.Get("/AvailablePhoneNumbers/"+countryCode+"/Local.json", requestOptions)
+	res, err := s.Client.GetContext(ctx, "/AvailablePhoneNumbers/"+countryCode+"/"+string(numberType)+".json", requestOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -54,27 +218,43 @@ func (s *AvailablePhoneNumberService) Local(countryCode string, requestOptions .
 	return search.AvailablePhoneNumbers, err
 }
 
-// Buy performs the update of the differents attributes of an Incoming Phone Number.
-// In case of a number with an address requirement you need to use the
-// web UI to buy one first
-// Doc: https://www.twilio.com/docs/api/rest/incoming-phone-numbers#list-post
-func (s *AvailablePhoneNumberService) Buy(availablePhoneNumber *AvailablePhoneNumber, requestOptions ...option.RequestOption) (*IncomingPhoneNumber, error) {
+// Countries performs a call to the twilio API to retrieve the countries where
+// phone numbers are available, along with their available number types.
+// Doc: https://www.twilio.com/docs/phone-numbers/api/availablephonenumber-resource#read-a-list-of-countries
+func (s *AvailablePhoneNumberService) Countries(requestOptions ...option.RequestOption) ([]AvailablePhoneNumberCountry, error) {
+	return s.CountriesContext(context.Background(), requestOptions...)
+}
 
-	updates := url.Values{}
-	updates.Set("PhoneNumber", availablePhoneNumber.PhoneNumber)
-	updates.Set("FriendlyName", availablePhoneNumber.FriendlyName)
+// CountriesContext performs the same call as Countries, bound to the given context.
+func (s *AvailablePhoneNumberService) CountriesContext(ctx context.Context, requestOptions ...option.RequestOption) ([]AvailablePhoneNumberCountry, error) {
+	var list struct {
+		Countries []AvailablePhoneNumberCountry `json:"countries"`
+	}
 
-	body, err := s.Client.Post("/IncomingPhoneNumbers.json", requestOptions, updates)
+	res, err := s.Client.GetContext(ctx, "/AvailablePhoneNumbers.json", requestOptions)
 	if err != nil {
 		return nil, err
 	}
 
-	var incomingPhoneNumber IncomingPhoneNumber
+	err = json.Unmarshal(res, &list)
 
-	err = json.Unmarshal(body, &incomingPhoneNumber)
-	if err != nil {
-		return nil, err
+	return list.Countries, err
+}
+
+// Buy performs the update of the differents attributes of an Incoming Phone Number.
+// In case of a number with an address requirement you need to use the
+// web UI to buy one first
+// Doc: https://www.twilio.com/docs/api/rest/incoming-phone-numbers#list-post
+func (s *AvailablePhoneNumberService) Buy(availablePhoneNumber *AvailablePhoneNumber, requestOptions ...option.RequestOption) (*IncomingPhoneNumber, error) {
+	return s.BuyContext(context.Background(), availablePhoneNumber, requestOptions...)
+}
+
+// BuyContext performs the same call as Buy, bound to the given context.
+func (s *AvailablePhoneNumberService) BuyContext(ctx context.Context, availablePhoneNumber *AvailablePhoneNumber, requestOptions ...option.RequestOption) (*IncomingPhoneNumber, error) {
+	params := &IncomingPhoneNumberParams{
+		PhoneNumber:  availablePhoneNumber.PhoneNumber,
+		FriendlyName: availablePhoneNumber.FriendlyName,
 	}
 
-	return &incomingPhoneNumber, nil
+	return (*IncomingPhoneNumberService)(s).CreateContext(ctx, params, requestOptions...)
 }
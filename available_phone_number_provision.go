@@ -0,0 +1,82 @@
+package twiliolo
+
+import (
+	"context"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// SearchCriterion is a search of available phone numbers tried by Provision.
+type SearchCriterion struct {
+	// Name identifies the criterion in the ProvisionResult, e.g. "area code 415".
+	Name        string
+	CountryCode string
+	// NumberType defaults to NumberTypeLocal.
+	NumberType NumberType
+	Options    []option.RequestOption
+}
+
+// ProvisionResult is the outcome of a successful Provision.
+type ProvisionResult struct {
+	IncomingPhoneNumber *IncomingPhoneNumber
+	// Criterion is the search criterion the bought phone number matched.
+	Criterion SearchCriterion
+	// CriterionIndex is the position of Criterion in the given criteria.
+	CriterionIndex int
+	// Attempts is the number of purchases attempted, including the successful one.
+	Attempts int
+}
+
+// Provision searches available phone numbers with each criterion in order and
+// tries to buy the numbers found until one purchase succeeds.
+// The bought number is configured with the given params, their PhoneNumber and
+// AreaCode being ignored. Numbers bought by someone else in the meantime are
+// skipped, any other error stops the provisioning.
+// ErrNoAvailablePhoneNumber is returned when no criterion led to a purchase.
+func (s *AvailablePhoneNumberService) Provision(criteria []SearchCriterion, params *IncomingPhoneNumberParams, requestOptions ...option.RequestOption) (*ProvisionResult, error) {
+	return s.ProvisionContext(context.Background(), criteria, params, requestOptions...)
+}
+
+// ProvisionContext performs the same calls as Provision, bound to the given context.
+func (s *AvailablePhoneNumberService) ProvisionContext(ctx context.Context, criteria []SearchCriterion, params *IncomingPhoneNumberParams, requestOptions ...option.RequestOption) (*ProvisionResult, error) {
+	config := IncomingPhoneNumberParams{}
+	if params != nil {
+		config = *params
+	}
+	config.AreaCode = ""
+
+	attempts := 0
+	for i, criterion := range criteria {
+		numberType := criterion.NumberType
+		if numberType == "" {
+			numberType = NumberTypeLocal
+		}
+
+		candidates, err := s.SearchContext(ctx, criterion.CountryCode, numberType, criterion.Options...)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, candidate := range candidates {
+			attempts++
+			config.PhoneNumber = candidate.PhoneNumber
+
+			incomingPhoneNumber, err := (*IncomingPhoneNumberService)(s).CreateContext(ctx, &config, requestOptions...)
+			if IsPhoneNumberUnavailable(err) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			return &ProvisionResult{
+				IncomingPhoneNumber: incomingPhoneNumber,
+				Criterion:           criterion,
+				CriterionIndex:      i,
+				Attempts:            attempts,
+			}, nil
+		}
+	}
+
+	return nil, ErrNoAvailablePhoneNumber
+}
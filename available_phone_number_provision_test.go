@@ -0,0 +1,93 @@
+package twiliolo_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+var testProvisionCriteria = []twiliolo.SearchCriterion{
+	{Name: "area code 415", CountryCode: "US", Options: []option.RequestOption{option.AreaCode("415")}},
+	{Name: "near", CountryCode: "US", Options: []option.RequestOption{option.NearNumber("+14155550100"), option.Distance(25)}},
+	{Name: "region", CountryCode: "US", Options: []option.RequestOption{option.InRegion("CA")}},
+}
+
+func TestAvailablePhoneNumberProvision(t *testing.T) {
+	t.Run("OK - Fallback to next criterion and skip unavailable numbers", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/AvailablePhoneNumbers/US/Local.json", uri)
+
+			if requestOptions[0] == option.AreaCode("415") {
+				return []byte(`{"available_phone_numbers": []}`), nil
+			}
+
+			return []byte(`{"available_phone_numbers": [{"phone_number": "+14155550101"}, {"phone_number": "+14155550102"}]}`), nil
+		}
+		client.PostFn = func(uri string, _ []option.RequestOption, values url.Values) ([]byte, error) {
+			assert.Equal(t, "/IncomingPhoneNumbers.json", uri)
+			assert.Equal(t, "http://voice.com", values.Get("VoiceUrl"))
+			assert.Equal(t, "Support", values.Get("FriendlyName"))
+			assert.Equal(t, "", values.Get("AreaCode"))
+
+			if values.Get("PhoneNumber") == "+14155550101" {
+				return nil, &twiliolo.TwilioError{Status: 400, HTTPStatus: 400, Code: twiliolo.ErrorCodePhoneNumberUnavailable}
+			}
+
+			return []byte(`{"sid": "PNTwilioloFake", "phone_number": "` + values.Get("PhoneNumber") + `"}`), nil
+		}
+
+		service := twiliolo.AvailablePhoneNumberService{Client: client}
+		result, err := service.Provision(testProvisionCriteria, &twiliolo.IncomingPhoneNumberParams{
+			AreaCode:     "415",
+			FriendlyName: "Support",
+			VoiceURL:     "http://voice.com",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, client.GetCall)
+		assert.Equal(t, 2, client.PostCall)
+		assert.Equal(t, "+14155550102", result.IncomingPhoneNumber.PhoneNumber)
+		assert.Equal(t, "near", result.Criterion.Name)
+		assert.Equal(t, 1, result.CriterionIndex)
+		assert.Equal(t, 2, result.Attempts)
+	})
+
+	t.Run("NOK - No number available", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+			return []byte(`{"available_phone_numbers": []}`), nil
+		}
+
+		service := twiliolo.AvailablePhoneNumberService{Client: client}
+		result, err := service.Provision(testProvisionCriteria, nil)
+
+		assert.Equal(t, twiliolo.ErrNoAvailablePhoneNumber, err)
+		assert.Nil(t, result)
+		assert.Equal(t, 3, client.GetCall)
+		assert.Equal(t, 0, client.PostCall)
+	})
+
+	t.Run("NOK - Purchase error stops the provisioning", func(t *testing.T) {
+		purchaseErr := &twiliolo.TwilioError{Status: 400, HTTPStatus: 400, Code: 21401}
+
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+			return []byte(`{"available_phone_numbers": [{"phone_number": "+14155550101"}, {"phone_number": "+14155550102"}]}`), nil
+		}
+		client.PostFn = func(uri string, _ []option.RequestOption, values url.Values) ([]byte, error) {
+			return nil, purchaseErr
+		}
+
+		service := twiliolo.AvailablePhoneNumberService{Client: client}
+		result, err := service.Provision(testProvisionCriteria, nil)
+
+		assert.Equal(t, purchaseErr, err)
+		assert.Nil(t, result)
+		assert.Equal(t, 1, client.PostCall)
+	})
+}
@@ -0,0 +1,78 @@
+package twiliolo
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// maxSearchDistance is the maximum Distance, in miles, accepted by Twilio.
+const maxSearchDistance = 500
+
+// northAmericanCountries are the only countries supporting the geographic search options.
+var northAmericanCountries = map[string]bool{"US": true, "CA": true}
+
+// validateSearchOptions checks that the given options can be used to search
+// phone numbers of the given type in the given country.
+// Geographic options are only supported for local numbers in the US and Canada,
+// AreaCode only in the US and Canada.
+func validateSearchOptions(countryCode string, numberType NumberType, requestOptions []option.RequestOption) error {
+	northAmerican := northAmericanCountries[strings.ToUpper(countryCode)]
+	near := false
+	distance := false
+
+	for _, requestOption := range requestOptions {
+		key, value := requestOption.GetValue()
+
+		switch o := requestOption.(type) {
+		case option.NearNumber, option.NearLatLong, option.Distance, option.InPostalCode, option.InRegion, option.InRateCenter, option.InLata:
+			if !northAmerican || numberType != NumberTypeLocal {
+				return fmt.Errorf("%w: %s is only supported for US and CA local numbers", ErrInvalidSearchOption, key)
+			}
+		case option.AreaCode:
+			if !northAmerican {
+				return fmt.Errorf("%w: %s is only supported for US and CA numbers", ErrInvalidSearchOption, key)
+			}
+		case option.Contains:
+			if !validContainsPattern(string(o)) {
+				return fmt.Errorf("%w: %s %q must be at least 2 digits, letters or *", ErrInvalidSearchOption, key, value)
+			}
+		}
+
+		switch o := requestOption.(type) {
+		case option.NearNumber:
+			near = true
+		case option.NearLatLong:
+			near = true
+			if o.Latitude < -90 || o.Latitude > 90 || o.Longitude < -180 || o.Longitude > 180 {
+				return fmt.Errorf("%w: %s %s is out of range", ErrInvalidSearchOption, key, value)
+			}
+		case option.Distance:
+			distance = true
+			if o < 0 || o > maxSearchDistance {
+				return fmt.Errorf("%w: %s must be between 0 and %d miles", ErrInvalidSearchOption, key, maxSearchDistance)
+			}
+		}
+	}
+
+	if distance && !near {
+		return fmt.Errorf("%w: Distance requires NearNumber or NearLatLong", ErrInvalidSearchOption)
+	}
+
+	return nil
+}
+
+func validContainsPattern(pattern string) bool {
+	if len(pattern) < 2 {
+		return false
+	}
+
+	for _, r := range pattern {
+		if !(r == '*' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') {
+			return false
+		}
+	}
+
+	return true
+}
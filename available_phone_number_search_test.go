@@ -0,0 +1,73 @@
+package twiliolo_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAvailablePhoneNumberSearchOptions(t *testing.T) {
+	t.Run("OK - Geographic options for US local numbers", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/AvailablePhoneNumbers/US/Local.json", uri)
+
+			key, value := requestOptions[1].GetValue()
+			assert.Equal(t, "NearLatLong", key)
+			assert.Equal(t, "37.840699,-122.461853", value)
+
+			return []byte(`{"available_phone_numbers": []}`), nil
+		}
+
+		service := twiliolo.AvailablePhoneNumberService{Client: client}
+		_, err := service.Local("US",
+			option.InRegion("CA"),
+			option.NearLatLong{Latitude: 37.840699, Longitude: -122.461853},
+			option.Distance(50),
+			option.Contains("510555****"),
+		)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, client.GetCall)
+	})
+
+	cases := []struct {
+		name           string
+		countryCode    string
+		numberType     twiliolo.NumberType
+		requestOptions []option.RequestOption
+	}{
+		{"Geographic option outside of US and CA", "FR", twiliolo.NumberTypeLocal, []option.RequestOption{option.InPostalCode("75001")}},
+		{"Geographic option for toll free numbers", "US", twiliolo.NumberTypeTollFree, []option.RequestOption{option.InLata("834")}},
+		{"AreaCode outside of US and CA", "GB", twiliolo.NumberTypeMobile, []option.RequestOption{option.AreaCode("7700")}},
+		{"Distance without a near option", "US", twiliolo.NumberTypeLocal, []option.RequestOption{option.Distance(10)}},
+		{"Distance too large", "CA", twiliolo.NumberTypeLocal, []option.RequestOption{option.NearNumber("+14165550100"), option.Distance(501)}},
+		{"Latitude out of range", "US", twiliolo.NumberTypeLocal, []option.RequestOption{option.NearLatLong{Latitude: 91}}},
+		{"Contains too short", "FR", twiliolo.NumberTypeLocal, []option.RequestOption{option.Contains("5")}},
+		{"Contains invalid character", "US", twiliolo.NumberTypeTollFree, []option.RequestOption{option.Contains("555-0100")}},
+	}
+
+	for _, c := range cases {
+		t.Run("NOK - "+c.name, func(t *testing.T) {
+			client := new(internal.MockAPIClient)
+			service := twiliolo.AvailablePhoneNumberService{Client: client}
+
+			list, err := service.Search(c.countryCode, c.numberType, c.requestOptions...)
+
+			assert.True(t, errors.Is(err, twiliolo.ErrInvalidSearchOption))
+			assert.Nil(t, list)
+			assert.Equal(t, 0, client.GetCall)
+		})
+	}
+}
+
+func TestExcludeForeignAddressRequiredKey(t *testing.T) {
+	key, value := option.ExcludeForeignAddressRequired(true).GetValue()
+
+	assert.Equal(t, "ExcludeForeignAddressRequired", key)
+	assert.Equal(t, "true", value)
+}
@@ -135,3 +135,82 @@ func TestAvailablePhoneNumberBuy(t *testing.T) {
 	assert.Equal(t, true, phone.Capabilities.Voice)
 	assert.Equal(t, "FriendlyName", phone.FriendlyName)
 }
+
+func TestAvailablePhoneNumberSearchByType(t *testing.T) {
+	cases := []struct {
+		numberType twiliolo.NumberType
+		search     func(*twiliolo.AvailablePhoneNumberService) ([]twiliolo.AvailablePhoneNumber, error)
+	}{
+		{twiliolo.NumberTypeTollFree, func(s *twiliolo.AvailablePhoneNumberService) ([]twiliolo.AvailablePhoneNumber, error) {
+			return s.TollFree("US")
+		}},
+		{twiliolo.NumberTypeMobile, func(s *twiliolo.AvailablePhoneNumberService) ([]twiliolo.AvailablePhoneNumber, error) {
+			return s.Mobile("US")
+		}},
+		{twiliolo.NumberTypeNational, func(s *twiliolo.AvailablePhoneNumberService) ([]twiliolo.AvailablePhoneNumber, error) {
+			return s.National("US")
+		}},
+		{twiliolo.NumberTypeSharedCost, func(s *twiliolo.AvailablePhoneNumberService) ([]twiliolo.AvailablePhoneNumber, error) {
+			return s.SharedCost("US")
+		}},
+		{twiliolo.NumberTypeVoip, func(s *twiliolo.AvailablePhoneNumberService) ([]twiliolo.AvailablePhoneNumber, error) {
+			return s.Voip("US")
+		}},
+		{twiliolo.NumberTypeMachineToMachine, func(s *twiliolo.AvailablePhoneNumberService) ([]twiliolo.AvailablePhoneNumber, error) {
+			return s.MachineToMachine("US")
+		}},
+		{twiliolo.NumberTypeLocal, func(s *twiliolo.AvailablePhoneNumberService) ([]twiliolo.AvailablePhoneNumber, error) {
+			return s.Search("US", twiliolo.NumberTypeLocal)
+		}},
+	}
+
+	for _, c := range cases {
+		t.Run("OK - "+string(c.numberType), func(t *testing.T) {
+			client := new(internal.MockAPIClient)
+			client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+				assert.Equal(t, "/AvailablePhoneNumbers/US/"+string(c.numberType)+".json", uri)
+
+				return []byte(`{"available_phone_numbers": [{"phone_number": "+18005550100", "iso_country": "US"}]}`), nil
+			}
+
+			list, err := c.search(&twiliolo.AvailablePhoneNumberService{Client: client})
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, client.GetCall)
+			assert.Equal(t, "+18005550100", list[0].PhoneNumber)
+		})
+	}
+}
+
+func TestAvailablePhoneNumberCountries(t *testing.T) {
+	client := new(internal.MockAPIClient)
+	client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+		assert.Equal(t, "/AvailablePhoneNumbers.json", uri)
+
+		return []byte(`
+		{
+			"uri": "\/2010-04-01\/Accounts\/TwilioloFake\/AvailablePhoneNumbers.json",
+			"countries": [
+				{
+					"country_code": "FR",
+					"country": "France",
+					"beta": false,
+					"uri": "\/2010-04-01\/Accounts\/TwilioloFake\/AvailablePhoneNumbers\/FR.json",
+					"subresource_uris": {
+						"mobile": "\/2010-04-01\/Accounts\/TwilioloFake\/AvailablePhoneNumbers\/FR\/Mobile.json",
+						"local": "\/2010-04-01\/Accounts\/TwilioloFake\/AvailablePhoneNumbers\/FR\/Local.json"
+					}
+				}
+			]
+		}`), nil
+	}
+
+	service := twiliolo.AvailablePhoneNumberService{Client: client}
+	countries, err := service.Countries()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(countries))
+	assert.Equal(t, "FR", countries[0].CountryCode)
+	assert.Equal(t, "France", countries[0].Country)
+	assert.Equal(t, []twiliolo.NumberType{twiliolo.NumberTypeLocal, twiliolo.NumberTypeMobile}, countries[0].NumberTypes())
+}
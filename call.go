@@ -0,0 +1,296 @@
+package twiliolo
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// CallServiceInterface is the interface of a CallService
+type CallServiceInterface interface {
+	Create(*CallParams, ...option.RequestOption) (*Call, error)
+	Get(string, ...option.RequestOption) (*Call, error)
+	Update(string, *CallUpdateParams, ...option.RequestOption) (*Call, error)
+	Delete(string, ...option.RequestOption) error
+	List(...option.RequestOption) (*CallList, error)
+	ListNextPage(*CallList) (*CallList, error)
+	Iter(...option.RequestOption) *Iterator[*Call]
+	CreateContext(context.Context, *CallParams, ...option.RequestOption) (*Call, error)
+	GetContext(context.Context, string, ...option.RequestOption) (*Call, error)
+	UpdateContext(context.Context, string, *CallUpdateParams, ...option.RequestOption) (*Call, error)
+	DeleteContext(context.Context, string, ...option.RequestOption) error
+	ListContext(context.Context, ...option.RequestOption) (*CallList, error)
+	ListNextPageContext(context.Context, *CallList) (*CallList, error)
+	IterContext(context.Context, ...option.RequestOption) *Iterator[*Call]
+}
+
+// CallService handles communication with the Call related methods.
+type CallService service
+
+// CallStatus is the status of a Call.
+type CallStatus string
+
+// Possible values of a CallStatus.
+const (
+	CallStatusQueued     CallStatus = "queued"
+	CallStatusRinging    CallStatus = "ringing"
+	CallStatusInProgress CallStatus = "in-progress"
+	CallStatusCanceled   CallStatus = "canceled"
+	CallStatusCompleted  CallStatus = "completed"
+	CallStatusBusy       CallStatus = "busy"
+	CallStatusNoAnswer   CallStatus = "no-answer"
+	CallStatusFailed     CallStatus = "failed"
+)
+
+// Call represents a Twilio voice call.
+type Call struct {
+	Sid             string            `json:"sid"`
+	ParentCallSid   string            `json:"parent_call_sid"`
+	AccountSid      string            `json:"account_sid"`
+	To              string            `json:"to"`
+	From            string            `json:"from"`
+	PhoneNumberSid  string            `json:"phone_number_sid"`
+	Status          CallStatus        `json:"status"`
+	StartTime       time.Time         `json:"start_time"`
+	EndTime         time.Time         `json:"end_time"`
+	Duration        string            `json:"duration"`
+	Price           string            `json:"price"`
+	PriceUnit       string            `json:"price_unit"`
+	Direction       string            `json:"direction"`
+	AnsweredBy      string            `json:"answered_by"`
+	ForwardedFrom   string            `json:"forwarded_from"`
+	CallerName      string            `json:"caller_name"`
+	QueueTime       string            `json:"queue_time"`
+	TrunkSid        string            `json:"trunk_sid"`
+	DateCreated     time.Time         `json:"date_created"`
+	DateUpdated     time.Time         `json:"date_updated"`
+	APIVersion      string            `json:"api_version"`
+	URI             string            `json:"uri"`
+	SubresourceURIs map[string]string `json:"subresource_uris"`
+}
+
+// UnmarshalJSON decodes a Call, parsing its RFC 2822 dates.
+func (c *Call) UnmarshalJSON(data []byte) error {
+	type call Call
+
+	raw := struct {
+		*call
+		StartTime   string `json:"start_time"`
+		EndTime     string `json:"end_time"`
+		DateCreated string `json:"date_created"`
+		DateUpdated string `json:"date_updated"`
+	}{call: (*call)(c)}
+
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	c.StartTime, err = parseDate(raw.StartTime)
+	if err != nil {
+		return err
+	}
+
+	c.EndTime, err = parseDate(raw.EndTime)
+	if err != nil {
+		return err
+	}
+
+	c.DateCreated, err = parseDate(raw.DateCreated)
+	if err != nil {
+		return err
+	}
+
+	c.DateUpdated, err = parseDate(raw.DateUpdated)
+
+	return err
+}
+
+// MarshalJSON encodes a Call, formatting its dates in RFC 2822 like Twilio.
+func (c Call) MarshalJSON() ([]byte, error) {
+	type call Call
+
+	return json.Marshal(struct {
+		call
+		StartTime   string `json:"start_time"`
+		EndTime     string `json:"end_time"`
+		DateCreated string `json:"date_created"`
+		DateUpdated string `json:"date_updated"`
+	}{
+		call:        call(c),
+		StartTime:   formatDate(c.StartTime),
+		EndTime:     formatDate(c.EndTime),
+		DateCreated: formatDate(c.DateCreated),
+		DateUpdated: formatDate(c.DateUpdated),
+	})
+}
+
+// CallParams contains the parameters used to originate a new Call.
+// To and From are required along with URL or Twiml.
+type CallParams struct {
+	To                   string
+	From                 string
+	URL                  string
+	Method               string
+	Twiml                string
+	StatusCallback       string
+	StatusCallbackMethod string
+	// StatusCallbackEvent lists the events sent to StatusCallback: initiated, ringing, answered and completed.
+	StatusCallbackEvent []string
+	// MachineDetection is either Enable or DetectMessageEnd.
+	MachineDetection string
+	// Timeout is the number of seconds to let the call ring, ignored when 0.
+	Timeout int
+	Record  bool
+}
+
+// CallUpdateParams contains the parameters used to modify an in-progress Call,
+// either redirecting it with URL or Twiml, or ending it with Status.
+type CallUpdateParams struct {
+	URL    string
+	Method string
+	Twiml  string
+	// Status is either CallStatusCanceled or CallStatusCompleted.
+	Status CallStatus
+}
+
+// Create originates a new outbound Call.
+// Doc: https://www.twilio.com/docs/voice/api/call-resource#create-a-call-resource
+func (s *CallService) Create(params *CallParams, requestOptions ...option.RequestOption) (*Call, error) {
+	return s.CreateContext(context.Background(), params, requestOptions...)
+}
+
+// CreateContext performs the same call as Create, bound to the given context.
+func (s *CallService) CreateContext(ctx context.Context, params *CallParams, requestOptions ...option.RequestOption) (*Call, error) {
+	if params == nil || params.To == "" || params.From == "" || (params.URL == "" && params.Twiml == "") {
+		return nil, ErrCallMissingData
+	}
+
+	values := url.Values{}
+	values.Set("To", params.To)
+	values.Set("From", params.From)
+	if params.URL != "" {
+		values.Set("Url", params.URL)
+	}
+	if params.Method != "" {
+		values.Set("Method", params.Method)
+	}
+	if params.Twiml != "" {
+		values.Set("Twiml", params.Twiml)
+	}
+	if params.StatusCallback != "" {
+		values.Set("StatusCallback", params.StatusCallback)
+	}
+	if params.StatusCallbackMethod != "" {
+		values.Set("StatusCallbackMethod", params.StatusCallbackMethod)
+	}
+	for _, event := range params.StatusCallbackEvent {
+		values.Add("StatusCallbackEvent", event)
+	}
+	if params.MachineDetection != "" {
+		values.Set("MachineDetection", params.MachineDetection)
+	}
+	if params.Timeout != 0 {
+		values.Set("Timeout", strconv.Itoa(params.Timeout))
+	}
+	if params.Record {
+		values.Set("Record", "true")
+	}
+
+	body, err := s.Client.PostContext(ctx, "/Calls.json", requestOptions, values)
+	if err != nil {
+		return nil, err
+	}
+
+	var call Call
+
+	err = json.Unmarshal(body, &call)
+	if err != nil {
+		return nil, err
+	}
+
+	return &call, nil
+}
+
+// Get performs a call to the twilio API to retrieve a Call with its Sid.
+// Doc: https://www.twilio.com/docs/voice/api/call-resource#fetch-a-call-resource
+func (s *CallService) Get(sid string, requestOptions ...option.RequestOption) (*Call, error) {
+	return s.GetContext(context.Background(), sid, requestOptions...)
+}
+
+// GetContext performs the same call as Get, bound to the given context.
+func (s *CallService) GetContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*Call, error) {
+	if sid == "" {
+		return nil, ErrCallMissingData
+	}
+
+	res, err := s.Client.GetContext(ctx, "/Calls/"+sid+".json", requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	call := new(Call)
+	err = json.Unmarshal(res, call)
+
+	return call, err
+}
+
+// Update redirects or ends an in-progress Call.
+// Doc: https://www.twilio.com/docs/voice/api/call-resource#update-a-call-resource
+func (s *CallService) Update(sid string, params *CallUpdateParams, requestOptions ...option.RequestOption) (*Call, error) {
+	return s.UpdateContext(context.Background(), sid, params, requestOptions...)
+}
+
+// UpdateContext performs the same call as Update, bound to the given context.
+func (s *CallService) UpdateContext(ctx context.Context, sid string, params *CallUpdateParams, requestOptions ...option.RequestOption) (*Call, error) {
+	if sid == "" || params == nil || (params.URL == "" && params.Twiml == "" && params.Status == "") {
+		return nil, ErrCallMissingData
+	}
+
+	updates := url.Values{}
+	if params.URL != "" {
+		updates.Set("Url", params.URL)
+	}
+	if params.Method != "" {
+		updates.Set("Method", params.Method)
+	}
+	if params.Twiml != "" {
+		updates.Set("Twiml", params.Twiml)
+	}
+	if params.Status != "" {
+		updates.Set("Status", string(params.Status))
+	}
+
+	body, err := s.Client.PostContext(ctx, "/Calls/"+sid+".json", requestOptions, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	var call Call
+
+	err = json.Unmarshal(body, &call)
+	if err != nil {
+		return nil, err
+	}
+
+	return &call, nil
+}
+
+// Delete removes a Call from the account logs.
+// Doc: https://www.twilio.com/docs/voice/api/call-resource#delete-a-call-resource
+func (s *CallService) Delete(sid string, requestOptions ...option.RequestOption) error {
+	return s.DeleteContext(context.Background(), sid, requestOptions...)
+}
+
+// DeleteContext performs the same call as Delete, bound to the given context.
+func (s *CallService) DeleteContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) error {
+	if sid == "" {
+		return ErrCallMissingData
+	}
+
+	return s.Client.DeleteContext(ctx, "/Calls/"+sid+".json", requestOptions)
+}
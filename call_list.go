@@ -0,0 +1,77 @@
+package twiliolo
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// CallList represents the response of the Twilio API when calling /Calls.json
+type CallList struct {
+	Page            int     `json:"page"`
+	PageSize        int     `json:"page_size"`
+	URI             string  `json:"uri"`
+	FirstPageURI    string  `json:"first_page_uri"`
+	NextPageURI     string  `json:"next_page_uri"`
+	PreviousPageURI string  `json:"previous_page_uri"`
+	Calls           []*Call `json:"calls"`
+}
+
+// List retrieves the first page of the Calls, filtered with the To, From,
+// Status, StartTime and ParentCallSid options.
+// Doc: https://www.twilio.com/docs/voice/api/call-resource#read-multiple-call-resources
+func (s *CallService) List(requestOptions ...option.RequestOption) (*CallList, error) {
+	return s.ListContext(context.Background(), requestOptions...)
+}
+
+// ListContext performs the same call as List, bound to the given context.
+func (s *CallService) ListContext(ctx context.Context, requestOptions ...option.RequestOption) (*CallList, error) {
+	body, err := s.Client.GetContext(ctx, "/Calls.json", requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	callList := new(CallList)
+	err = json.Unmarshal(body, callList)
+
+	return callList, err
+}
+
+// ListNextPage retrieves the next page of a given CallList by following its NextPageURI.
+// If an empty NextPageURI is present in the struct it'll return an error
+func (s *CallService) ListNextPage(previousList *CallList) (*CallList, error) {
+	return s.ListNextPageContext(context.Background(), previousList)
+}
+
+// ListNextPageContext performs the same call as ListNextPage, bound to the given context.
+func (s *CallService) ListNextPageContext(ctx context.Context, previousList *CallList) (*CallList, error) {
+	if previousList == nil || previousList.NextPageURI == "" {
+		return nil, ErrNoNextPage
+	}
+
+	body, err := s.Client.GetContext(ctx, previousList.NextPageURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	callList := new(CallList)
+	err = json.Unmarshal(body, callList)
+
+	return callList, err
+}
+
+// Iter returns an Iterator over all the Calls matching the given options.
+func (s *CallService) Iter(requestOptions ...option.RequestOption) *Iterator[*Call] {
+	return s.IterContext(context.Background(), requestOptions...)
+}
+
+// IterContext performs the same calls as Iter, bound to the given context.
+func (s *CallService) IterContext(ctx context.Context, requestOptions ...option.RequestOption) *Iterator[*Call] {
+	return newIterator(ctx, s.Client, "/Calls.json", requestOptions, func(body []byte) ([]*Call, string, error) {
+		list := new(CallList)
+		err := json.Unmarshal(body, list)
+
+		return list.Calls, list.NextPageURI, err
+	})
+}
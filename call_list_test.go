@@ -0,0 +1,48 @@
+package twiliolo_test
+
+import (
+	"testing"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallList(t *testing.T) {
+	client := new(internal.MockAPIClient)
+	client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+		assert.Equal(t, "/Calls.json", uri)
+		assert.Equal(t, []option.RequestOption{option.Status("completed"), option.ParentCallSid("CATwilioloParent")}, requestOptions)
+
+		return []byte(`
+		{
+			"page": 0,
+			"page_size": 50,
+			"next_page_uri": "\/2010-04-01\/Accounts\/TwilioloFake\/Calls.json?Status=completed&Page=1&PageSize=50&PageToken=PACATwilioloFake",
+			"calls": [{"sid": "CATwilioloFake", "parent_call_sid": "CATwilioloParent", "status": "completed"}]
+		}`), nil
+	}
+
+	service := twiliolo.CallService{Client: client}
+	list, err := service.List(option.Status("completed"), option.ParentCallSid("CATwilioloParent"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(list.Calls))
+	assert.Equal(t, "CATwilioloParent", list.Calls[0].ParentCallSid)
+
+	client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+		assert.Equal(t, list.NextPageURI, uri)
+
+		return []byte(`{"page": 1, "page_size": 50, "next_page_uri": null, "calls": []}`), nil
+	}
+
+	nextList, err := service.ListNextPage(list)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, nextList.Page)
+
+	_, err = service.ListNextPage(nextList)
+
+	assert.Equal(t, twiliolo.ErrNoNextPage, err)
+}
@@ -0,0 +1,206 @@
+package twiliolo_test
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+const testCallResponse = `
+{
+	"sid": "CATwilioloFake",
+	"parent_call_sid": null,
+	"account_sid": "TwilioloFake",
+	"to": "+33687654321",
+	"from": "+33612345678",
+	"phone_number_sid": "TwiliololIncomingFake",
+	"status": "queued",
+	"start_time": null,
+	"end_time": null,
+	"duration": null,
+	"price": null,
+	"direction": "outbound-api",
+	"answered_by": null,
+	"date_created": "Tue, 31 Aug 2010 20:36:28 +0000",
+	"date_updated": "Tue, 31 Aug 2010 20:36:44 +0000",
+	"api_version": "2010-04-01",
+	"uri": "\/2010-04-01\/Accounts\/TwilioloFake\/Calls\/CATwilioloFake.json",
+	"subresource_uris": {
+		"recordings": "\/2010-04-01\/Accounts\/TwilioloFake\/Calls\/CATwilioloFake\/Recordings.json"
+	}
+}`
+
+func TestCallCreate(t *testing.T) {
+	t.Run("OK - Call originated", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.PostFn = func(uri string, _ []option.RequestOption, values url.Values) ([]byte, error) {
+			assert.Equal(t, "/Calls.json", uri)
+			assert.Equal(t, "+33687654321", values.Get("To"))
+			assert.Equal(t, "+33612345678", values.Get("From"))
+			assert.Equal(t, "<Response><Say>Hello</Say></Response>", values.Get("Twiml"))
+			assert.Equal(t, "", values.Get("Url"))
+			assert.Equal(t, []string{"initiated", "completed"}, values["StatusCallbackEvent"])
+			assert.Equal(t, "Enable", values.Get("MachineDetection"))
+			assert.Equal(t, "30", values.Get("Timeout"))
+			assert.Equal(t, "true", values.Get("Record"))
+
+			return []byte(testCallResponse), nil
+		}
+
+		service := twiliolo.CallService{Client: client}
+		call, err := service.Create(&twiliolo.CallParams{
+			To:                  "+33687654321",
+			From:                "+33612345678",
+			Twiml:               "<Response><Say>Hello</Say></Response>",
+			StatusCallback:      "http://status.com",
+			StatusCallbackEvent: []string{"initiated", "completed"},
+			MachineDetection:    "Enable",
+			Timeout:             30,
+			Record:              true,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "CATwilioloFake", call.Sid)
+		assert.Equal(t, twiliolo.CallStatusQueued, call.Status)
+	})
+
+	t.Run("NOK - Missing instructions", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.CallService{Client: client}
+
+		call, err := service.Create(&twiliolo.CallParams{To: "+33687654321", From: "+33612345678"})
+
+		assert.Equal(t, twiliolo.ErrCallMissingData, err)
+		assert.Nil(t, call)
+		assert.Equal(t, 0, client.PostCall)
+	})
+}
+
+func TestCallGet(t *testing.T) {
+	t.Run("OK - Get Call", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/Calls/CATwilioloFake.json", uri)
+
+			return []byte(testCallResponse), nil
+		}
+
+		service := twiliolo.CallService{Client: client}
+		call, err := service.Get("CATwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "CATwilioloFake", call.Sid)
+		assert.Equal(t, "TwiliololIncomingFake", call.PhoneNumberSid)
+		assert.Equal(t, "/2010-04-01/Accounts/TwilioloFake/Calls/CATwilioloFake/Recordings.json", call.SubresourceURIs["recordings"])
+		assert.True(t, call.StartTime.IsZero())
+		assert.True(t, call.EndTime.IsZero())
+		assert.Equal(t, time.Date(2010, time.August, 31, 20, 36, 28, 0, time.UTC), call.DateCreated)
+		assert.Equal(t, time.Date(2010, time.August, 31, 20, 36, 44, 0, time.UTC), call.DateUpdated)
+	})
+
+	t.Run("NOK - Missing sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.CallService{Client: client}
+
+		call, err := service.Get("")
+
+		assert.Equal(t, twiliolo.ErrCallMissingData, err)
+		assert.Nil(t, call)
+		assert.Equal(t, 0, client.GetCall)
+	})
+}
+
+func TestCallMarshalJSON(t *testing.T) {
+	call := twiliolo.Call{
+		Sid:         "CATwilioloFake",
+		StartTime:   time.Date(2010, time.August, 31, 20, 36, 30, 0, time.UTC),
+		DateCreated: time.Date(2010, time.August, 31, 20, 36, 28, 0, time.UTC),
+	}
+
+	data, err := json.Marshal(call)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"start_time":"Tue, 31 Aug 2010 20:36:30 +0000"`)
+	assert.Contains(t, string(data), `"end_time":""`)
+
+	var decoded twiliolo.Call
+
+	err = json.Unmarshal(data, &decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, call, decoded)
+}
+
+func TestCallUpdate(t *testing.T) {
+	t.Run("OK - Hang up", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.PostFn = func(uri string, _ []option.RequestOption, values url.Values) ([]byte, error) {
+			assert.Equal(t, "/Calls/CATwilioloFake.json", uri)
+			assert.Equal(t, url.Values{"Status": []string{"completed"}}, values)
+
+			return []byte(`{"sid": "CATwilioloFake", "status": "completed"}`), nil
+		}
+
+		service := twiliolo.CallService{Client: client}
+		call, err := service.Update("CATwilioloFake", &twiliolo.CallUpdateParams{Status: twiliolo.CallStatusCompleted})
+
+		assert.NoError(t, err)
+		assert.Equal(t, twiliolo.CallStatusCompleted, call.Status)
+	})
+
+	t.Run("OK - Redirect", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.PostFn = func(uri string, _ []option.RequestOption, values url.Values) ([]byte, error) {
+			assert.Equal(t, "http://redirect.com", values.Get("Url"))
+			assert.Equal(t, "GET", values.Get("Method"))
+
+			return []byte(`{"sid": "CATwilioloFake", "status": "in-progress"}`), nil
+		}
+
+		service := twiliolo.CallService{Client: client}
+		call, err := service.Update("CATwilioloFake", &twiliolo.CallUpdateParams{URL: "http://redirect.com", Method: "GET"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, twiliolo.CallStatusInProgress, call.Status)
+	})
+
+	t.Run("NOK - Nothing to update", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.CallService{Client: client}
+
+		_, err := service.Update("CATwilioloFake", &twiliolo.CallUpdateParams{})
+
+		assert.Equal(t, twiliolo.ErrCallMissingData, err)
+	})
+}
+
+func TestCallDelete(t *testing.T) {
+	t.Run("OK - Delete Call", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.DeleteFn = func(uri string, _ []option.RequestOption) error {
+			assert.Equal(t, "/Calls/CATwilioloFake.json", uri)
+
+			return nil
+		}
+
+		service := twiliolo.CallService{Client: client}
+		err := service.Delete("CATwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, client.DeleteCall)
+	})
+
+	t.Run("NOK - Missing sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.CallService{Client: client}
+
+		err := service.Delete("")
+
+		assert.Equal(t, twiliolo.ErrCallMissingData, err)
+		assert.Equal(t, 0, client.DeleteCall)
+	})
+}
@@ -1,6 +1,8 @@
 package twiliolo
 
 import (
+	"context"
+	"io"
 	"net/url"
 
 	"github.com/genesor/twiliolo/option"
@@ -15,6 +17,11 @@ type APIClient interface {
 	Get(string, []option.RequestOption) ([]byte, error)
 	Post(string, []option.RequestOption, url.Values) ([]byte, error)
 	Delete(string, []option.RequestOption) error
+	GetContext(context.Context, string, []option.RequestOption) ([]byte, error)
+	PostContext(context.Context, string, []option.RequestOption, url.Values) ([]byte, error)
+	DeleteContext(context.Context, string, []option.RequestOption) error
+	Download(string, []option.RequestOption, io.Writer) error
+	DownloadContext(context.Context, string, []option.RequestOption, io.Writer) error
 }
 
 // TwilioClient is the struct containing all other services
@@ -22,14 +29,60 @@ type TwilioClient struct {
 	common               service // Reuse a single struct instead of allocating one for each service on the heap.
 	IncomingPhoneNumber  IncomingPhoneNumberServiceInterface
 	AvailablePhoneNumber AvailablePhoneNumberServiceInterface
+	Message              MessageServiceInterface
+	Call                 CallServiceInterface
+	Account              AccountServiceInterface
+	Key                  KeyServiceInterface
+	Recording            RecordingServiceInterface
+	Transcription        TranscriptionServiceInterface
+	Conference           ConferenceServiceInterface
+	Participant          ParticipantServiceInterface
+	Queue                QueueServiceInterface
+	QueueMember          QueueMemberServiceInterface
+	Application          ApplicationServiceInterface
+	OutgoingCallerID     OutgoingCallerIDServiceInterface
 }
 
 // NewClient instanciates a new TwilioClient
 func NewClient(accountSid string, authToken string, httpClient HTTPClient) *TwilioClient {
+	return NewClientWithAPIClient(NewTwilioAPIClient(accountSid, authToken, httpClient))
+}
+
+// NewClientWithAPIClient instanciates a new TwilioClient using the given APIClient,
+// allowing the use of a customized TwilioAPIClient.
+func NewClientWithAPIClient(apiClient APIClient) *TwilioClient {
 	c := TwilioClient{}
-	c.common.Client = NewTwilioAPIClient(accountSid, authToken, httpClient)
+	c.common.Client = apiClient
 	c.IncomingPhoneNumber = (*IncomingPhoneNumberService)(&c.common)
 	c.AvailablePhoneNumber = (*AvailablePhoneNumberService)(&c.common)
+	c.Message = (*MessageService)(&c.common)
+	c.Call = (*CallService)(&c.common)
+	c.Account = (*AccountService)(&c.common)
+	c.Key = (*KeyService)(&c.common)
+	c.Recording = (*RecordingService)(&c.common)
+	c.Transcription = (*TranscriptionService)(&c.common)
+	c.Conference = (*ConferenceService)(&c.common)
+	c.Participant = (*ParticipantService)(&c.common)
+	c.Queue = (*QueueService)(&c.common)
+	c.QueueMember = (*QueueMemberService)(&c.common)
+	c.Application = (*ApplicationService)(&c.common)
+	c.OutgoingCallerID = (*OutgoingCallerIDService)(&c.common)
 
 	return &c
 }
+
+// Subaccount returns a TwilioClient acting on the given subaccount while
+// authenticating with the credentials of the current client.
+// It requires the client to use a TwilioAPIClient.
+func (c *TwilioClient) Subaccount(accountSid string) (*TwilioClient, error) {
+	if accountSid == "" {
+		return nil, ErrAccountMissingData
+	}
+
+	apiClient, ok := c.common.Client.(*TwilioAPIClient)
+	if !ok {
+		return nil, ErrSubaccountUnsupported
+	}
+
+	return NewClientWithAPIClient(apiClient.ForAccount(accountSid)), nil
+}
@@ -1,6 +1,8 @@
 package twiliolo_test
 
 import (
+	"net/http"
+	"strconv"
 	"testing"
 
 	"github.com/genesor/twiliolo"
@@ -14,3 +16,91 @@ func TestNewClient(t *testing.T) {
 	assert.IsType(t, &twiliolo.TwilioClient{}, client)
 	assert.IsType(t, &twiliolo.IncomingPhoneNumberService{}, client.IncomingPhoneNumber)
 }
+
+func TestNewClientWithAPIClient(t *testing.T) {
+	apiClient := new(internal.MockAPIClient)
+	client := twiliolo.NewClientWithAPIClient(apiClient)
+
+	assert.IsType(t, &twiliolo.TwilioClient{}, client)
+	assert.IsType(t, &twiliolo.AvailablePhoneNumberService{}, client.AvailablePhoneNumber)
+}
+
+func TestClientSubaccount(t *testing.T) {
+	t.Run("OK - Scoped to subaccount with parent credentials", func(t *testing.T) {
+		httpMock := internal.HTTPMockClient{}
+		httpMock.DoFn = func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, twiliolo.ROOT+"/"+twiliolo.VERSION+"/Accounts/ACSubFake/Calls/CAFake.json", req.URL.String())
+
+			user, password, ok := req.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, ACCOUNT_SID, user)
+			assert.Equal(t, AUTH_TOKEN, password)
+
+			return &http.Response{
+				Status:     strconv.Itoa(200),
+				StatusCode: 200,
+				Body:       internal.NewRespBodyFromString(`{"sid": "CAFake", "account_sid": "ACSubFake"}`),
+				Header:     http.Header{},
+			}, nil
+		}
+
+		client := twiliolo.NewClient(ACCOUNT_SID, AUTH_TOKEN, &httpMock)
+		subClient, err := client.Subaccount("ACSubFake")
+		assert.NoError(t, err)
+
+		call, err := subClient.Call.Get("CAFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, httpMock.DoCall)
+		assert.Equal(t, "ACSubFake", call.AccountSid)
+	})
+
+	t.Run("OK - Custom root kept for the subaccount and its Accounts", func(t *testing.T) {
+		urls := make([]string, 0)
+		httpMock := internal.HTTPMockClient{}
+		httpMock.DoFn = func(req *http.Request) (*http.Response, error) {
+			urls = append(urls, req.URL.String())
+
+			return &http.Response{
+				Status:     strconv.Itoa(200),
+				StatusCode: 200,
+				Body:       internal.NewRespBodyFromString(`{"sid": "ACSubFake"}`),
+				Header:     http.Header{},
+			}, nil
+		}
+
+		apiClient := twiliolo.NewTwilioAPIClient(ACCOUNT_SID, AUTH_TOKEN, &httpMock)
+		apiClient.RootURL = "http://localhost:8080/" + twiliolo.VERSION + "/Accounts/" + ACCOUNT_SID
+		client := twiliolo.NewClientWithAPIClient(apiClient)
+		subClient, err := client.Subaccount("ACSubFake")
+		assert.NoError(t, err)
+
+		_, err = subClient.Call.Get("CAFake")
+		assert.NoError(t, err)
+		_, err = client.Account.Get("ACSubFake")
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{
+			"http://localhost:8080/2010-04-01/Accounts/ACSubFake/Calls/CAFake.json",
+			"http://localhost:8080/2010-04-01/Accounts/ACSubFake.json",
+		}, urls)
+	})
+
+	t.Run("NOK - Unsupported APIClient", func(t *testing.T) {
+		client := twiliolo.NewClientWithAPIClient(new(internal.MockAPIClient))
+		subClient, err := client.Subaccount("ACSubFake")
+
+		assert.Equal(t, twiliolo.ErrSubaccountUnsupported, err)
+		assert.Nil(t, subClient)
+	})
+
+	t.Run("NOK - Missing account sid", func(t *testing.T) {
+		httpMock := internal.HTTPMockClient{}
+		client := twiliolo.NewClient(ACCOUNT_SID, AUTH_TOKEN, &httpMock)
+		subClient, err := client.Subaccount("")
+
+		assert.Equal(t, twiliolo.ErrAccountMissingData, err)
+		assert.Nil(t, subClient)
+		assert.Equal(t, 0, httpMock.DoCall)
+	})
+}
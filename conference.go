@@ -0,0 +1,146 @@
+package twiliolo
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// ConferenceServiceInterface is the interface of a ConferenceService
+type ConferenceServiceInterface interface {
+	Get(string, ...option.RequestOption) (*Conference, error)
+	End(string, ...option.RequestOption) (*Conference, error)
+	List(...option.RequestOption) (*ConferenceList, error)
+	ListNextPage(*ConferenceList) (*ConferenceList, error)
+	Iter(...option.RequestOption) *Iterator[*Conference]
+	GetContext(context.Context, string, ...option.RequestOption) (*Conference, error)
+	EndContext(context.Context, string, ...option.RequestOption) (*Conference, error)
+	ListContext(context.Context, ...option.RequestOption) (*ConferenceList, error)
+	ListNextPageContext(context.Context, *ConferenceList) (*ConferenceList, error)
+	IterContext(context.Context, ...option.RequestOption) *Iterator[*Conference]
+}
+
+// ConferenceService handles communication with the Conference related methods.
+type ConferenceService service
+
+// ConferenceStatus is the status of a Conference.
+type ConferenceStatus string
+
+// Possible values of a ConferenceStatus.
+const (
+	ConferenceStatusInit       ConferenceStatus = "init"
+	ConferenceStatusInProgress ConferenceStatus = "in-progress"
+	ConferenceStatusCompleted  ConferenceStatus = "completed"
+)
+
+// Conference represents a Twilio conference call.
+type Conference struct {
+	Sid                     string            `json:"sid"`
+	AccountSid              string            `json:"account_sid"`
+	FriendlyName            string            `json:"friendly_name"`
+	Status                  ConferenceStatus  `json:"status"`
+	Region                  string            `json:"region"`
+	ReasonConferenceEnded   string            `json:"reason_conference_ended"`
+	CallSidEndingConference string            `json:"call_sid_ending_conference"`
+	DateCreated             time.Time         `json:"date_created"`
+	DateUpdated             time.Time         `json:"date_updated"`
+	APIVersion              string            `json:"api_version"`
+	URI                     string            `json:"uri"`
+	SubresourceURIs         map[string]string `json:"subresource_uris"`
+}
+
+// UnmarshalJSON decodes a Conference, parsing its RFC 2822 dates.
+func (c *Conference) UnmarshalJSON(data []byte) error {
+	type conference Conference
+
+	raw := struct {
+		*conference
+		DateCreated string `json:"date_created"`
+		DateUpdated string `json:"date_updated"`
+	}{conference: (*conference)(c)}
+
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	c.DateCreated, err = parseDate(raw.DateCreated)
+	if err != nil {
+		return err
+	}
+
+	c.DateUpdated, err = parseDate(raw.DateUpdated)
+
+	return err
+}
+
+// MarshalJSON encodes a Conference, formatting its dates in RFC 2822 like Twilio.
+func (c Conference) MarshalJSON() ([]byte, error) {
+	type conference Conference
+
+	return json.Marshal(struct {
+		conference
+		DateCreated string `json:"date_created"`
+		DateUpdated string `json:"date_updated"`
+	}{
+		conference:  conference(c),
+		DateCreated: formatDate(c.DateCreated),
+		DateUpdated: formatDate(c.DateUpdated),
+	})
+}
+
+// Get performs a call to the twilio API to retrieve a Conference with its Sid.
+// Doc: https://www.twilio.com/docs/voice/api/conference-resource#fetch-a-conference-resource
+func (s *ConferenceService) Get(sid string, requestOptions ...option.RequestOption) (*Conference, error) {
+	return s.GetContext(context.Background(), sid, requestOptions...)
+}
+
+// GetContext performs the same call as Get, bound to the given context.
+func (s *ConferenceService) GetContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*Conference, error) {
+	if sid == "" {
+		return nil, ErrConferenceMissingData
+	}
+
+	res, err := s.Client.GetContext(ctx, "/Conferences/"+sid+".json", requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	conference := new(Conference)
+	err = json.Unmarshal(res, conference)
+
+	return conference, err
+}
+
+// End ends a Conference, disconnecting all its participants.
+// Doc: https://www.twilio.com/docs/voice/api/conference-resource#update-a-conference-resource
+func (s *ConferenceService) End(sid string, requestOptions ...option.RequestOption) (*Conference, error) {
+	return s.EndContext(context.Background(), sid, requestOptions...)
+}
+
+// EndContext performs the same call as End, bound to the given context.
+func (s *ConferenceService) EndContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*Conference, error) {
+	if sid == "" {
+		return nil, ErrConferenceMissingData
+	}
+
+	values := url.Values{}
+	values.Set("Status", string(ConferenceStatusCompleted))
+
+	body, err := s.Client.PostContext(ctx, "/Conferences/"+sid+".json", requestOptions, values)
+	if err != nil {
+		return nil, err
+	}
+
+	var conference Conference
+
+	err = json.Unmarshal(body, &conference)
+	if err != nil {
+		return nil, err
+	}
+
+	return &conference, nil
+}
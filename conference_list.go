@@ -0,0 +1,77 @@
+package twiliolo
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// ConferenceList represents the response of the Twilio API when calling /Conferences.json
+type ConferenceList struct {
+	Page            int           `json:"page"`
+	PageSize        int           `json:"page_size"`
+	URI             string        `json:"uri"`
+	FirstPageURI    string        `json:"first_page_uri"`
+	NextPageURI     string        `json:"next_page_uri"`
+	PreviousPageURI string        `json:"previous_page_uri"`
+	Conferences     []*Conference `json:"conferences"`
+}
+
+// List retrieves the first page of the Conferences, filtered with the Status,
+// FriendlyName and DateCreated options.
+// Doc: https://www.twilio.com/docs/voice/api/conference-resource#read-multiple-conference-resources
+func (s *ConferenceService) List(requestOptions ...option.RequestOption) (*ConferenceList, error) {
+	return s.ListContext(context.Background(), requestOptions...)
+}
+
+// ListContext performs the same call as List, bound to the given context.
+func (s *ConferenceService) ListContext(ctx context.Context, requestOptions ...option.RequestOption) (*ConferenceList, error) {
+	body, err := s.Client.GetContext(ctx, "/Conferences.json", requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	conferenceList := new(ConferenceList)
+	err = json.Unmarshal(body, conferenceList)
+
+	return conferenceList, err
+}
+
+// ListNextPage retrieves the next page of a given ConferenceList by following its NextPageURI.
+// If an empty NextPageURI is present in the struct it'll return an error
+func (s *ConferenceService) ListNextPage(previousList *ConferenceList) (*ConferenceList, error) {
+	return s.ListNextPageContext(context.Background(), previousList)
+}
+
+// ListNextPageContext performs the same call as ListNextPage, bound to the given context.
+func (s *ConferenceService) ListNextPageContext(ctx context.Context, previousList *ConferenceList) (*ConferenceList, error) {
+	if previousList == nil || previousList.NextPageURI == "" {
+		return nil, ErrNoNextPage
+	}
+
+	body, err := s.Client.GetContext(ctx, previousList.NextPageURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	conferenceList := new(ConferenceList)
+	err = json.Unmarshal(body, conferenceList)
+
+	return conferenceList, err
+}
+
+// Iter returns an Iterator over all the Conferences matching the given options.
+func (s *ConferenceService) Iter(requestOptions ...option.RequestOption) *Iterator[*Conference] {
+	return s.IterContext(context.Background(), requestOptions...)
+}
+
+// IterContext performs the same calls as Iter, bound to the given context.
+func (s *ConferenceService) IterContext(ctx context.Context, requestOptions ...option.RequestOption) *Iterator[*Conference] {
+	return newIterator(ctx, s.Client, "/Conferences.json", requestOptions, func(body []byte) ([]*Conference, string, error) {
+		list := new(ConferenceList)
+		err := json.Unmarshal(body, list)
+
+		return list.Conferences, list.NextPageURI, err
+	})
+}
@@ -0,0 +1,61 @@
+package twiliolo_test
+
+import (
+	"testing"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConferenceList(t *testing.T) {
+	client := new(internal.MockAPIClient)
+	client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+		assert.Equal(t, "/Conferences.json", uri)
+		assert.Equal(t, []option.RequestOption{option.Status("in-progress"), option.FriendlyName("Daily standup")}, requestOptions)
+
+		return []byte(`
+		{
+			"page": 0,
+			"page_size": 50,
+			"next_page_uri": "\/2010-04-01\/Accounts\/TwilioloFake\/Conferences.json?Page=1&PageSize=50&PageToken=PACFTwilioloFake",
+			"conferences": [{"sid": "CFTwilioloFake", "friendly_name": "Daily standup", "status": "in-progress"}]
+		}`), nil
+	}
+
+	service := twiliolo.ConferenceService{Client: client}
+	list, err := service.List(option.Status("in-progress"), option.FriendlyName("Daily standup"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(list.Conferences))
+	assert.Equal(t, twiliolo.ConferenceStatusInProgress, list.Conferences[0].Status)
+	assert.NotEmpty(t, list.NextPageURI)
+}
+
+func TestConferenceListNextPage(t *testing.T) {
+	t.Run("OK - Follow next page URI", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/2010-04-01/Accounts/TwilioloFake/Conferences.json?Page=1", uri)
+			assert.Empty(t, requestOptions)
+
+			return []byte(`{"page": 1, "conferences": [{"sid": "CFTwilioloFake2"}]}`), nil
+		}
+
+		service := twiliolo.ConferenceService{Client: client}
+		list, err := service.ListNextPage(&twiliolo.ConferenceList{NextPageURI: "/2010-04-01/Accounts/TwilioloFake/Conferences.json?Page=1"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "CFTwilioloFake2", list.Conferences[0].Sid)
+	})
+
+	t.Run("NOK - No next page", func(t *testing.T) {
+		service := twiliolo.ConferenceService{Client: new(internal.MockAPIClient)}
+
+		list, err := service.ListNextPage(&twiliolo.ConferenceList{})
+
+		assert.Equal(t, twiliolo.ErrNoNextPage, err)
+		assert.Nil(t, list)
+	})
+}
@@ -0,0 +1,88 @@
+package twiliolo_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+const testConferenceResponse = `
+{
+	"sid": "CFTwilioloFake",
+	"account_sid": "TwilioloFake",
+	"friendly_name": "Daily standup",
+	"status": "in-progress",
+	"region": "us1",
+	"date_created": "Mon, 16 Aug 2010 03:45:01 +0000",
+	"date_updated": "Mon, 16 Aug 2010 03:45:01 +0000",
+	"api_version": "2010-04-01",
+	"uri": "\/2010-04-01\/Accounts\/TwilioloFake\/Conferences\/CFTwilioloFake.json",
+	"subresource_uris": {
+		"participants": "\/2010-04-01\/Accounts\/TwilioloFake\/Conferences\/CFTwilioloFake\/Participants.json"
+	}
+}`
+
+func TestConferenceGet(t *testing.T) {
+	t.Run("OK - Conference retrieved", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/Conferences/CFTwilioloFake.json", uri)
+
+			return []byte(testConferenceResponse), nil
+		}
+
+		service := twiliolo.ConferenceService{Client: client}
+		conference, err := service.Get("CFTwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Daily standup", conference.FriendlyName)
+		assert.Equal(t, time.Date(2010, time.August, 16, 3, 45, 1, 0, time.UTC), conference.DateCreated)
+		assert.Equal(t, time.Date(2010, time.August, 16, 3, 45, 1, 0, time.UTC), conference.DateUpdated)
+		assert.Equal(t, twiliolo.ConferenceStatusInProgress, conference.Status)
+		assert.Equal(t, "/2010-04-01/Accounts/TwilioloFake/Conferences/CFTwilioloFake/Participants.json", conference.SubresourceURIs["participants"])
+	})
+
+	t.Run("NOK - Missing sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.ConferenceService{Client: client}
+
+		conference, err := service.Get("")
+
+		assert.Equal(t, twiliolo.ErrConferenceMissingData, err)
+		assert.Nil(t, conference)
+	})
+}
+
+func TestConferenceEnd(t *testing.T) {
+	t.Run("OK - Conference ended", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.PostFn = func(uri string, _ []option.RequestOption, values url.Values) ([]byte, error) {
+			assert.Equal(t, "/Conferences/CFTwilioloFake.json", uri)
+			assert.Equal(t, "completed", values.Get("Status"))
+
+			return []byte(`{"sid": "CFTwilioloFake", "status": "completed"}`), nil
+		}
+
+		service := twiliolo.ConferenceService{Client: client}
+		conference, err := service.End("CFTwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, twiliolo.ConferenceStatusCompleted, conference.Status)
+	})
+
+	t.Run("NOK - Missing sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.ConferenceService{Client: client}
+
+		conference, err := service.End("")
+
+		assert.Equal(t, twiliolo.ErrConferenceMissingData, err)
+		assert.Nil(t, conference)
+		assert.Equal(t, 0, client.PostCall)
+	})
+}
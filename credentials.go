@@ -0,0 +1,86 @@
+package twiliolo
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+// CredentialProvider is the interface of a source of the credentials used to
+// authenticate the requests sent by a TwilioAPIClient.
+// It is called before every attempt of every request so credentials can be rotated at runtime.
+type CredentialProvider interface {
+	// Credentials returns the basic auth username and password, being either
+	// an Account Sid and its Auth Token or an API Key Sid and its Secret.
+	Credentials(context.Context) (username, password string, err error)
+}
+
+// CredentialProviderFunc is an adapter to use an ordinary function, reading
+// a secret store for instance, as a CredentialProvider.
+type CredentialProviderFunc func(context.Context) (string, string, error)
+
+// Credentials calls f(ctx).
+func (f CredentialProviderFunc) Credentials(ctx context.Context) (string, string, error) {
+	return f(ctx)
+}
+
+// StaticCredentials is a CredentialProvider always returning the same credentials.
+type StaticCredentials struct {
+	Username string
+	Password string
+}
+
+// Credentials returns the static credentials.
+func (c StaticCredentials) Credentials(context.Context) (string, string, error) {
+	if c.Username == "" || c.Password == "" {
+		return "", "", ErrMissingCredentials
+	}
+
+	return c.Username, c.Password, nil
+}
+
+// EnvCredentials is a CredentialProvider reading the credentials from environment variables.
+type EnvCredentials struct {
+	UsernameVar string
+	PasswordVar string
+}
+
+// NewEnvCredentials instanciates a new EnvCredentials
+func NewEnvCredentials(usernameVar, passwordVar string) *EnvCredentials {
+	return &EnvCredentials{
+		UsernameVar: usernameVar,
+		PasswordVar: passwordVar,
+	}
+}
+
+// Credentials reads the credentials from the environment.
+func (c *EnvCredentials) Credentials(ctx context.Context) (string, string, error) {
+	return StaticCredentials{
+		Username: os.Getenv(c.UsernameVar),
+		Password: os.Getenv(c.PasswordVar),
+	}.Credentials(ctx)
+}
+
+// FileCredentials is a CredentialProvider reading the credentials from a file
+// containing "username:password", the file being read again on every call.
+type FileCredentials struct {
+	Path string
+}
+
+// NewFileCredentials instanciates a new FileCredentials
+func NewFileCredentials(path string) *FileCredentials {
+	return &FileCredentials{Path: path}
+}
+
+// Credentials reads the credentials from the file.
+func (c *FileCredentials) Credentials(ctx context.Context) (string, string, error) {
+	content, err := ioutil.ReadFile(c.Path)
+	if err != nil {
+		return "", "", err
+	}
+
+	username, password, _ := strings.Cut(strings.TrimSpace(string(content)), ":")
+
+	return StaticCredentials{Username: username, Password: password}.Credentials(ctx)
+}
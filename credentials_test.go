@@ -0,0 +1,74 @@
+package twiliolo_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/genesor/twiliolo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStaticCredentials(t *testing.T) {
+	t.Run("OK - Credentials returned", func(t *testing.T) {
+		username, password, err := twiliolo.StaticCredentials{Username: "SKFake", Password: "Secret"}.Credentials(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SKFake", username)
+		assert.Equal(t, "Secret", password)
+	})
+
+	t.Run("NOK - Missing password", func(t *testing.T) {
+		_, _, err := twiliolo.StaticCredentials{Username: "SKFake"}.Credentials(context.Background())
+
+		assert.Equal(t, twiliolo.ErrMissingCredentials, err)
+	})
+}
+
+func TestEnvCredentials(t *testing.T) {
+	t.Setenv("TWILIOLO_TEST_SID", "SKFake")
+	t.Setenv("TWILIOLO_TEST_SECRET", "Secret")
+
+	provider := twiliolo.NewEnvCredentials("TWILIOLO_TEST_SID", "TWILIOLO_TEST_SECRET")
+	username, password, err := provider.Credentials(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "SKFake", username)
+	assert.Equal(t, "Secret", password)
+
+	t.Setenv("TWILIOLO_TEST_SECRET", "Rotated")
+	_, password, err = provider.Credentials(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Rotated", password)
+}
+
+func TestFileCredentials(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials")
+	provider := twiliolo.NewFileCredentials(path)
+
+	t.Run("NOK - Missing file", func(t *testing.T) {
+		_, _, err := provider.Credentials(context.Background())
+
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("OK - Credentials read", func(t *testing.T) {
+		assert.NoError(t, os.WriteFile(path, []byte("SKFake:Secret\n"), 0600))
+
+		username, password, err := provider.Credentials(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SKFake", username)
+		assert.Equal(t, "Secret", password)
+	})
+
+	t.Run("NOK - Malformated file", func(t *testing.T) {
+		assert.NoError(t, os.WriteFile(path, []byte("SKFake"), 0600))
+
+		_, _, err := provider.Credentials(context.Background())
+
+		assert.Equal(t, twiliolo.ErrMissingCredentials, err)
+	})
+}
@@ -0,0 +1,29 @@
+package twiliolo
+
+import "time"
+
+// dateLayout is the RFC 2822 layout of the dates returned by Twilio.
+const dateLayout = time.RFC1123Z
+
+// parseDate parses a date returned by Twilio, an empty date being the zero time.
+func parseDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	date, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return date.UTC(), nil
+}
+
+// formatDate formats a date the way Twilio does, the zero time being an empty date.
+func formatDate(date time.Time) string {
+	if date.IsZero() {
+		return ""
+	}
+
+	return date.Format(dateLayout)
+}
@@ -6,12 +6,66 @@ import (
 )
 
 var (
+	// ErrNoNextPage used when there is no next page in a list while trying to retrieve the next page
+	ErrNoNextPage = errors.New("No NextPageURI available")
 	// ErrIncomingPhoneListNoNextPage used when there is no next page in a list of incoming call while trying to retrieve the next page
-	ErrIncomingPhoneListNoNextPage = errors.New("No NextPageURI available")
-	// ErrTwilioServer used when Twilio throws a 500
+	//
+	// Deprecated: use ErrNoNextPage, which every ListNextPage returns.
+	ErrIncomingPhoneListNoNextPage = ErrNoNextPage
+	// ErrTwilioServer matches, with errors.Is, the TwilioError returned when Twilio throws a 5XX
 	ErrTwilioServer = errors.New("Twilio Server Error")
+	// ErrNotFound matches, with errors.Is, the TwilioError returned when a resource does not exist
+	ErrNotFound = errors.New("Twilio resource not found")
+	// ErrTooManyRequests matches, with errors.Is, the TwilioError returned when Twilio rate limits a request
+	ErrTooManyRequests = errors.New("Too many requests")
 	//ErrIncomingPhoneMissingData used when there is missing required data to perform in an IncomingPhoneNumber to perform an action
 	ErrIncomingPhoneMissingData = errors.New("Missing required data in the IncomingPhoneNumber ")
+	// ErrMessageMissingData used when there is missing required data in a Message to perform an action
+	ErrMessageMissingData = errors.New("Missing required data in the Message")
+	// ErrCallMissingData used when there is missing required data to perform an action on a Call
+	ErrCallMissingData = errors.New("Missing required data for the Call")
+	// ErrAccountMissingData used when there is missing required data to perform an action on an Account
+	ErrAccountMissingData = errors.New("Missing required data for the Account")
+	// ErrSubaccountUnsupported used when the APIClient of a TwilioClient cannot be scoped to a subaccount
+	ErrSubaccountUnsupported = errors.New("The APIClient cannot be scoped to a subaccount")
+	// ErrKeyMissingData used when there is missing required data to perform an action on a Key
+	ErrKeyMissingData = errors.New("Missing required data for the Key")
+	// ErrMissingCredentials used when a CredentialProvider has no username or password to return
+	ErrMissingCredentials = errors.New("Missing credentials")
+	// ErrInvalidSearchOption used when an option cannot be used to search available phone numbers of a country and type
+	ErrInvalidSearchOption = errors.New("Invalid search option")
+	// ErrNoAvailablePhoneNumber used when no phone number could be bought with any of the given search criteria
+	ErrNoAvailablePhoneNumber = errors.New("No phone number available for the search criteria")
+	// ErrUnsupportedNumberType used when a NumberType cannot be used to list the Incoming Phone Numbers
+	ErrUnsupportedNumberType = errors.New("Unsupported number type")
+	// ErrRecordingMissingData used when there is missing required data to perform an action on a Recording
+	ErrRecordingMissingData = errors.New("Missing required data for the Recording")
+	// ErrTranscriptionMissingData used when there is missing required data to perform an action on a Transcription
+	ErrTranscriptionMissingData = errors.New("Missing required data for the Transcription")
+	// ErrConferenceMissingData used when there is missing required data to perform an action on a Conference
+	ErrConferenceMissingData = errors.New("Missing required data for the Conference")
+	// ErrParticipantMissingData used when there is missing required data to perform an action on a Participant
+	ErrParticipantMissingData = errors.New("Missing required data for the Participant")
+	// ErrQueueMissingData used when there is missing required data to perform an action on a Queue
+	ErrQueueMissingData = errors.New("Missing required data for the Queue")
+	// ErrQueueMemberMissingData used when there is missing required data to perform an action on a Queue Member
+	ErrQueueMemberMissingData = errors.New("Missing required data for the Queue Member")
+	// ErrApplicationMissingData used when there is missing required data to perform an action on an Application
+	ErrApplicationMissingData = errors.New("Missing required data for the Application")
+	// ErrOutgoingCallerIDMissingData used when there is missing required data to perform an action on an Outgoing Caller ID
+	ErrOutgoingCallerIDMissingData = errors.New("Missing required data for the Outgoing Caller ID")
+	// ErrRateLimited used when a Limiter in fail fast mode refuses to send a request
+	ErrRateLimited = errors.New("Client-side rate limit reached")
+)
+
+// Twilio error codes commonly handled by the callers.
+// Doc: https://www.twilio.com/docs/api/errors
+const (
+	ErrorCodeNotFound               = 20404
+	ErrorCodeTooManyRequests        = 20429
+	ErrorCodeInvalidTo              = 21211
+	ErrorCodePhoneNumberUnavailable = 21422
+	ErrorCodeUnsubscribed           = 21610
 )
 
 // TwilioError is an error returned by the Twilio API
@@ -20,6 +74,16 @@ type TwilioError struct {
 	Message  string `json:"message"`
 	Code     int    `json:"code"`
 	MoreInfo string `json:"more_info"`
+
+	// HTTPStatus is the status code of the HTTP response.
+	HTTPStatus int `json:"-"`
+	// Method and URL describe the request which failed.
+	Method string `json:"-"`
+	URL    string `json:"-"`
+	// RequestID is the Twilio-Request-Id header of the response, useful when contacting the Twilio support.
+	RequestID string `json:"-"`
+	// Body is the raw body of the response.
+	Body []byte `json:"-"`
 }
 
 func (e TwilioError) Error() string {
@@ -41,3 +105,66 @@ func (e TwilioError) Error() string {
 
 	return message
 }
+
+// Is allows errors.Is to match a TwilioError against ErrTwilioServer, ErrNotFound and ErrTooManyRequests.
+func (e TwilioError) Is(target error) bool {
+	switch target {
+	case ErrTwilioServer:
+		return e.HTTPStatus >= 500
+	case ErrNotFound:
+		return e.HTTPStatus == 404 || e.Code == ErrorCodeNotFound
+	case ErrTooManyRequests:
+		return e.HTTPStatus == 429 || e.Code == ErrorCodeTooManyRequests
+	}
+
+	return false
+}
+
+// Retryable reports whether the same request may succeed when sent again,
+// i.e. on rate limiting and server errors.
+func (e TwilioError) Retryable() bool {
+	return e.Is(ErrTooManyRequests) || e.Is(ErrTwilioServer)
+}
+
+// Temporary reports whether the error is temporary, it is the same as Retryable.
+func (e TwilioError) Temporary() bool {
+	return e.Retryable()
+}
+
+// IsNotFound reports whether err is a TwilioError for a missing resource.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsTooManyRequests reports whether err is a TwilioError for a rate limited request.
+func IsTooManyRequests(err error) bool {
+	return errors.Is(err, ErrTooManyRequests)
+}
+
+// IsInvalidTo reports whether err is a TwilioError for an invalid To phone number.
+func IsInvalidTo(err error) bool {
+	return hasErrorCode(err, ErrorCodeInvalidTo)
+}
+
+// IsPhoneNumberUnavailable reports whether err is a TwilioError for a phone number which cannot be bought anymore.
+func IsPhoneNumberUnavailable(err error) bool {
+	return hasErrorCode(err, ErrorCodePhoneNumberUnavailable)
+}
+
+// IsUnsubscribed reports whether err is a TwilioError for a recipient who opted out of messages.
+func IsUnsubscribed(err error) bool {
+	return hasErrorCode(err, ErrorCodeUnsubscribed)
+}
+
+// IsRetryable reports whether err is a TwilioError for which the request may be retried.
+func IsRetryable(err error) bool {
+	var twilioError *TwilioError
+
+	return errors.As(err, &twilioError) && twilioError.Retryable()
+}
+
+func hasErrorCode(err error, code int) bool {
+	var twilioError *TwilioError
+
+	return errors.As(err, &twilioError) && twilioError.Code == code
+}
@@ -0,0 +1,46 @@
+package twiliolo_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/genesor/twiliolo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTwilioError(t *testing.T) {
+	t.Run("OK - Not found", func(t *testing.T) {
+		var err error = &twiliolo.TwilioError{Status: 404, HTTPStatus: 404, Code: twiliolo.ErrorCodeNotFound, Message: "Not found"}
+		wrapped := fmt.Errorf("fetching number: %w", err)
+
+		assert.True(t, twiliolo.IsNotFound(wrapped))
+		assert.True(t, errors.Is(wrapped, twiliolo.ErrNotFound))
+		assert.False(t, errors.Is(wrapped, twiliolo.ErrTwilioServer))
+		assert.False(t, twiliolo.IsRetryable(wrapped))
+		assert.Equal(t, "Twilio Error, Status: 404, Code: 20404, Message: Not found", err.Error())
+	})
+
+	t.Run("OK - Too many requests", func(t *testing.T) {
+		err := &twiliolo.TwilioError{HTTPStatus: 429, Code: twiliolo.ErrorCodeTooManyRequests}
+
+		assert.True(t, twiliolo.IsTooManyRequests(err))
+		assert.True(t, err.Retryable())
+		assert.True(t, err.Temporary())
+	})
+
+	t.Run("OK - Unsubscribed", func(t *testing.T) {
+		err := &twiliolo.TwilioError{HTTPStatus: 400, Code: twiliolo.ErrorCodeUnsubscribed}
+
+		assert.True(t, twiliolo.IsUnsubscribed(err))
+		assert.False(t, twiliolo.IsInvalidTo(err))
+		assert.False(t, err.Retryable())
+	})
+
+	t.Run("OK - Other errors", func(t *testing.T) {
+		err := errors.New("network error")
+
+		assert.False(t, twiliolo.IsNotFound(err))
+		assert.False(t, twiliolo.IsRetryable(err))
+	})
+}
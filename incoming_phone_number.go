@@ -1,9 +1,11 @@
 package twiliolo
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/genesor/twiliolo/option"
 )
@@ -11,40 +13,157 @@ import (
 // IncomingPhoneNumberServiceInterface is the interface of a IncomingPhoneNumberService
 type IncomingPhoneNumberServiceInterface interface {
 	Get(string, ...option.RequestOption) (*IncomingPhoneNumber, error)
-	Update(*IncomingPhoneNumber, ...option.RequestOption) error
+	Update(string, *IncomingPhoneNumberUpdate, ...option.RequestOption) (*IncomingPhoneNumber, error)
+	TransferToAccount(string, string, ...option.RequestOption) (*IncomingPhoneNumber, error)
+	Create(*IncomingPhoneNumberParams, ...option.RequestOption) (*IncomingPhoneNumber, error)
+	Delete(string, ...option.RequestOption) error
 	All() ([]*IncomingPhoneNumber, error)
 	List(...option.RequestOption) (*IncomingPhoneNumberList, error)
 	ListNextPage(*IncomingPhoneNumberList, ...option.RequestOption) (*IncomingPhoneNumberList, error)
+	Iter(...option.RequestOption) *Iterator[*IncomingPhoneNumber]
+	ListByType(NumberType, ...option.RequestOption) (*IncomingPhoneNumberList, error)
+	IterByType(NumberType, ...option.RequestOption) *Iterator[*IncomingPhoneNumber]
+	GetContext(context.Context, string, ...option.RequestOption) (*IncomingPhoneNumber, error)
+	UpdateContext(context.Context, string, *IncomingPhoneNumberUpdate, ...option.RequestOption) (*IncomingPhoneNumber, error)
+	TransferToAccountContext(context.Context, string, string, ...option.RequestOption) (*IncomingPhoneNumber, error)
+	CreateContext(context.Context, *IncomingPhoneNumberParams, ...option.RequestOption) (*IncomingPhoneNumber, error)
+	DeleteContext(context.Context, string, ...option.RequestOption) error
+	AllContext(context.Context) ([]*IncomingPhoneNumber, error)
+	ListContext(context.Context, ...option.RequestOption) (*IncomingPhoneNumberList, error)
+	ListNextPageContext(context.Context, *IncomingPhoneNumberList, ...option.RequestOption) (*IncomingPhoneNumberList, error)
+	IterContext(context.Context, ...option.RequestOption) *Iterator[*IncomingPhoneNumber]
+	ListByTypeContext(context.Context, NumberType, ...option.RequestOption) (*IncomingPhoneNumberList, error)
+	IterByTypeContext(context.Context, NumberType, ...option.RequestOption) *Iterator[*IncomingPhoneNumber]
 }
 
 // IncomingPhoneNumberService handles communication with the Incoming Phone Number related methods.
 type IncomingPhoneNumberService service
 
+// IncomingPhoneNumberStatus is the provisioning status of an IncomingPhoneNumber.
+type IncomingPhoneNumberStatus string
+
+// Possible values of an IncomingPhoneNumberStatus.
+const (
+	IncomingPhoneNumberStatusInUse        IncomingPhoneNumberStatus = "in-use"
+	IncomingPhoneNumberStatusPending      IncomingPhoneNumberStatus = "pending"
+	IncomingPhoneNumberStatusPortInFailed IncomingPhoneNumberStatus = "port-in-failed"
+)
+
+// EmergencyStatus is the emergency calling status of an IncomingPhoneNumber.
+type EmergencyStatus string
+
+// Possible values of an EmergencyStatus.
+const (
+	EmergencyStatusActive   EmergencyStatus = "Active"
+	EmergencyStatusInactive EmergencyStatus = "Inactive"
+)
+
+// VoiceReceiveMode is the kind of incoming calls an IncomingPhoneNumber receives.
+type VoiceReceiveMode string
+
+// Possible values of a VoiceReceiveMode.
+const (
+	VoiceReceiveModeVoice VoiceReceiveMode = "voice"
+	VoiceReceiveModeFax   VoiceReceiveMode = "fax"
+)
+
+// Origin is the origin of an IncomingPhoneNumber.
+type Origin string
+
+// Possible values of an Origin.
+const (
+	OriginTwilio Origin = "twilio"
+	OriginHosted Origin = "hosted"
+)
+
+// AddressRequirement is the kind of address required to buy a phone number.
+type AddressRequirement string
+
+// Possible values of an AddressRequirement.
+const (
+	AddressRequirementNone    AddressRequirement = "none"
+	AddressRequirementAny     AddressRequirement = "any"
+	AddressRequirementLocal   AddressRequirement = "local"
+	AddressRequirementForeign AddressRequirement = "foreign"
+)
+
 // IncomingPhoneNumber represents a Twilio Incoming Phone Number.
 type IncomingPhoneNumber struct {
-	Sid                  string       `json:"sid"`
-	AccountSid           string       `json:"account_sid"`
-	FriendlyName         string       `json:"friendly_name"`
-	PhoneNumber          string       `json:"phone_number"`
-	VoiceURL             string       `json:"voice_url"`
-	VoiceMethod          string       `json:"voice_method"`
-	VoiceFallbackURL     string       `json:"voice_fallback_url"`
-	VoiceFallbackMethod  string       `json:"voice_fallback_method"`
-	StatusCallback       string       `json:"status_callback"`
-	StatusCallbackMethod string       `json:"status_callback_method"`
-	VoiceCallerIDLookup  bool         `json:"voice_caller_id_lookup"`
-	VoiceApplicationSid  string       `json:"voice_application_sid"`
-	DateCreated          string       `json:"date_created"`
-	DateUpdated          string       `json:"date_updated"`
-	SmsURL               string       `json:"sms_url"`
-	SmsMethod            string       `json:"sms_method"`
-	SmsFallbackURL       string       `json:"sms_fallback_url"`
-	SmsFallbackMethod    string       `json:"sms_fallback_method"`
-	SmsApplicationSid    string       `json:"sms_application_sid"`
-	Capabilities         Capabilities `json:"capabilities"`
-	Beta                 bool         `json:"beta"`
-	APIVersion           string       `json:"api_version"`
-	URI                  string       `json:"uri"`
+	Sid                    string                    `json:"sid"`
+	AccountSid             string                    `json:"account_sid"`
+	FriendlyName           string                    `json:"friendly_name"`
+	PhoneNumber            string                    `json:"phone_number"`
+	VoiceURL               string                    `json:"voice_url"`
+	VoiceMethod            string                    `json:"voice_method"`
+	VoiceFallbackURL       string                    `json:"voice_fallback_url"`
+	VoiceFallbackMethod    string                    `json:"voice_fallback_method"`
+	StatusCallback         string                    `json:"status_callback"`
+	StatusCallbackMethod   string                    `json:"status_callback_method"`
+	VoiceCallerIDLookup    bool                      `json:"voice_caller_id_lookup"`
+	VoiceApplicationSid    string                    `json:"voice_application_sid"`
+	VoiceReceiveMode       VoiceReceiveMode          `json:"voice_receive_mode"`
+	DateCreated            time.Time                 `json:"date_created"`
+	DateUpdated            time.Time                 `json:"date_updated"`
+	SmsURL                 string                    `json:"sms_url"`
+	SmsMethod              string                    `json:"sms_method"`
+	SmsFallbackURL         string                    `json:"sms_fallback_url"`
+	SmsFallbackMethod      string                    `json:"sms_fallback_method"`
+	SmsApplicationSid      string                    `json:"sms_application_sid"`
+	AddressSid             string                    `json:"address_sid"`
+	AddressRequirements    AddressRequirement        `json:"address_requirements"`
+	IdentitySid            string                    `json:"identity_sid"`
+	BundleSid              string                    `json:"bundle_sid"`
+	EmergencyStatus        EmergencyStatus           `json:"emergency_status"`
+	EmergencyAddressSid    string                    `json:"emergency_address_sid"`
+	EmergencyAddressStatus string                    `json:"emergency_address_status"`
+	TrunkSid               string                    `json:"trunk_sid"`
+	Origin                 Origin                    `json:"origin"`
+	Status                 IncomingPhoneNumberStatus `json:"status"`
+	Capabilities           Capabilities              `json:"capabilities"`
+	Beta                   bool                      `json:"beta"`
+	APIVersion             string                    `json:"api_version"`
+	URI                    string                    `json:"uri"`
+}
+
+// UnmarshalJSON decodes an IncomingPhoneNumber, parsing its RFC 2822 dates.
+func (n *IncomingPhoneNumber) UnmarshalJSON(data []byte) error {
+	type incomingPhoneNumber IncomingPhoneNumber
+
+	raw := struct {
+		*incomingPhoneNumber
+		DateCreated string `json:"date_created"`
+		DateUpdated string `json:"date_updated"`
+	}{incomingPhoneNumber: (*incomingPhoneNumber)(n)}
+
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	n.DateCreated, err = parseDate(raw.DateCreated)
+	if err != nil {
+		return err
+	}
+
+	n.DateUpdated, err = parseDate(raw.DateUpdated)
+
+	return err
+}
+
+// MarshalJSON encodes an IncomingPhoneNumber, formatting its dates in RFC 2822
+// like Twilio so that it can be decoded again.
+func (n IncomingPhoneNumber) MarshalJSON() ([]byte, error) {
+	type incomingPhoneNumber IncomingPhoneNumber
+
+	return json.Marshal(struct {
+		incomingPhoneNumber
+		DateCreated string `json:"date_created"`
+		DateUpdated string `json:"date_updated"`
+	}{
+		incomingPhoneNumber: incomingPhoneNumber(n),
+		DateCreated:         formatDate(n.DateCreated),
+		DateUpdated:         formatDate(n.DateUpdated),
+	})
 }
 
 // Capabilities represents a Twilio Incoming Phone Number capabilities (MMS, SMS, Voice).
@@ -54,12 +173,166 @@ type Capabilities struct {
 	MMS   bool `json:"MMS"`
 }
 
+// IncomingPhoneNumberParams contains the parameters used to provision a new Incoming Phone Number.
+// Either PhoneNumber or AreaCode is required, the other settings are only sent when set.
+type IncomingPhoneNumberParams struct {
+	PhoneNumber          string
+	AreaCode             string
+	FriendlyName         string
+	VoiceURL             string
+	VoiceMethod          string
+	VoiceFallbackURL     string
+	VoiceFallbackMethod  string
+	VoiceCallerIDLookup  bool
+	VoiceApplicationSid  string
+	StatusCallback       string
+	StatusCallbackMethod string
+	SmsURL               string
+	SmsMethod            string
+	SmsFallbackURL       string
+	SmsFallbackMethod    string
+	SmsApplicationSid    string
+	VoiceReceiveMode     VoiceReceiveMode
+	AddressSid           string
+	IdentitySid          string
+	BundleSid            string
+	EmergencyStatus      EmergencyStatus
+	EmergencyAddressSid  string
+	TrunkSid             string
+}
+
+func (p *IncomingPhoneNumberParams) values() url.Values {
+	values := url.Values{}
+
+	fields := []struct {
+		key   string
+		value string
+	}{
+		{"PhoneNumber", p.PhoneNumber},
+		{"AreaCode", p.AreaCode},
+		{"FriendlyName", p.FriendlyName},
+		{"VoiceUrl", p.VoiceURL},
+		{"VoiceMethod", p.VoiceMethod},
+		{"VoiceFallbackUrl", p.VoiceFallbackURL},
+		{"VoiceFallbackMethod", p.VoiceFallbackMethod},
+		{"VoiceApplicationSid", p.VoiceApplicationSid},
+		{"StatusCallback", p.StatusCallback},
+		{"StatusCallbackMethod", p.StatusCallbackMethod},
+		{"SmsUrl", p.SmsURL},
+		{"SmsMethod", p.SmsMethod},
+		{"SmsFallbackUrl", p.SmsFallbackURL},
+		{"SmsFallbackMethod", p.SmsFallbackMethod},
+		{"SmsApplicationSid", p.SmsApplicationSid},
+		{"VoiceReceiveMode", string(p.VoiceReceiveMode)},
+		{"AddressSid", p.AddressSid},
+		{"IdentitySid", p.IdentitySid},
+		{"BundleSid", p.BundleSid},
+		{"EmergencyStatus", string(p.EmergencyStatus)},
+		{"EmergencyAddressSid", p.EmergencyAddressSid},
+		{"TrunkSid", p.TrunkSid},
+	}
+
+	for _, field := range fields {
+		if field.value != "" {
+			values.Set(field.key, field.value)
+		}
+	}
+
+	if p.VoiceCallerIDLookup {
+		values.Set("VoiceCallerIdLookup", "true")
+	}
+
+	return values
+}
+
+// IncomingPhoneNumberUpdate contains the fields to update on an Incoming Phone Number.
+// A nil field is left untouched while a pointer to an empty value clears the field,
+// use String and Bool to set them. Empty enums are left untouched.
+type IncomingPhoneNumberUpdate struct {
+	FriendlyName         *string
+	APIVersion           *string
+	VoiceURL             *string
+	VoiceMethod          *string
+	VoiceFallbackURL     *string
+	VoiceFallbackMethod  *string
+	VoiceCallerIDLookup  *bool
+	VoiceApplicationSid  *string
+	StatusCallback       *string
+	StatusCallbackMethod *string
+	SmsURL               *string
+	SmsMethod            *string
+	SmsFallbackURL       *string
+	SmsFallbackMethod    *string
+	SmsApplicationSid    *string
+	VoiceReceiveMode     VoiceReceiveMode
+	AddressSid           *string
+	IdentitySid          *string
+	BundleSid            *string
+	EmergencyStatus      EmergencyStatus
+	EmergencyAddressSid  *string
+	TrunkSid             *string
+}
+
+func (u *IncomingPhoneNumberUpdate) values() url.Values {
+	values := url.Values{}
+
+	fields := []struct {
+		key   string
+		value *string
+	}{
+		{"FriendlyName", u.FriendlyName},
+		{"ApiVersion", u.APIVersion},
+		{"VoiceUrl", u.VoiceURL},
+		{"VoiceMethod", u.VoiceMethod},
+		{"VoiceFallbackUrl", u.VoiceFallbackURL},
+		{"VoiceFallbackMethod", u.VoiceFallbackMethod},
+		{"VoiceApplicationSid", u.VoiceApplicationSid},
+		{"StatusCallback", u.StatusCallback},
+		{"StatusCallbackMethod", u.StatusCallbackMethod},
+		{"SmsUrl", u.SmsURL},
+		{"SmsMethod", u.SmsMethod},
+		{"SmsFallbackUrl", u.SmsFallbackURL},
+		{"SmsFallbackMethod", u.SmsFallbackMethod},
+		{"SmsApplicationSid", u.SmsApplicationSid},
+		{"AddressSid", u.AddressSid},
+		{"IdentitySid", u.IdentitySid},
+		{"BundleSid", u.BundleSid},
+		{"EmergencyAddressSid", u.EmergencyAddressSid},
+		{"TrunkSid", u.TrunkSid},
+	}
+
+	for _, field := range fields {
+		if field.value != nil {
+			values.Set(field.key, *field.value)
+		}
+	}
+
+	if u.VoiceCallerIDLookup != nil {
+		values.Set("VoiceCallerIdLookup", strconv.FormatBool(*u.VoiceCallerIDLookup))
+	}
+
+	if u.VoiceReceiveMode != "" {
+		values.Set("VoiceReceiveMode", string(u.VoiceReceiveMode))
+	}
+
+	if u.EmergencyStatus != "" {
+		values.Set("EmergencyStatus", string(u.EmergencyStatus))
+	}
+
+	return values
+}
+
 // Get performs a call to the twilio API to retrieve an Incoming Phone Number with its Sid.
 // Doc: https://www.twilio.com/docs/api/rest/incoming-phone-numbers#instance-get
 func (s *IncomingPhoneNumberService) Get(sid string, requestOptions ...option.RequestOption) (*IncomingPhoneNumber, error) {
+	return s.GetContext(context.Background(), sid, requestOptions...)
+}
+
+// GetContext performs the same call as Get, bound to the given context.
+func (s *IncomingPhoneNumberService) GetContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*IncomingPhoneNumber, error) {
 	var incomingPhoneNumber *IncomingPhoneNumber
 
-	res, err := s.Client.Get("/IncomingPhoneNumbers/"+sid+".json", requestOptions)
+	res, err := s.Client.GetContext(ctx, "/IncomingPhoneNumbers/"+sid+".json", requestOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -70,73 +343,105 @@ func (s *IncomingPhoneNumberService) Get(sid string, requestOptions ...option.Re
 	return incomingPhoneNumber, err
 }
 
-// Update performs the update of the differents attributes of an Incoming Phone Number.
+// Update performs a partial update of an Incoming Phone Number, only the
+// fields set in the IncomingPhoneNumberUpdate are sent.
 // Doc: https://www.twilio.com/docs/api/rest/incoming-phone-numbers#instance-post
-func (s *IncomingPhoneNumberService) Update(incomingPhoneNumber *IncomingPhoneNumber, requestOptions ...option.RequestOption) error {
-	if incomingPhoneNumber == nil || incomingPhoneNumber.Sid == "" {
-		return ErrIncomingPhoneMissingData
+func (s *IncomingPhoneNumberService) Update(sid string, update *IncomingPhoneNumberUpdate, requestOptions ...option.RequestOption) (*IncomingPhoneNumber, error) {
+	return s.UpdateContext(context.Background(), sid, update, requestOptions...)
+}
+
+// UpdateContext performs the same call as Update, bound to the given context.
+func (s *IncomingPhoneNumberService) UpdateContext(ctx context.Context, sid string, update *IncomingPhoneNumberUpdate, requestOptions ...option.RequestOption) (*IncomingPhoneNumber, error) {
+	if sid == "" || update == nil {
+		return nil, ErrIncomingPhoneMissingData
+	}
+
+	return s.post(ctx, "/IncomingPhoneNumbers/"+sid+".json", requestOptions, update.values())
+}
+
+// TransferToAccount transfers an Incoming Phone Number to another account,
+// both accounts must belong to the same parent account.
+// Doc: https://www.twilio.com/docs/phone-numbers/api/incomingphonenumber-resource#update-an-incomingphonenumber-resource
+func (s *IncomingPhoneNumberService) TransferToAccount(sid, accountSid string, requestOptions ...option.RequestOption) (*IncomingPhoneNumber, error) {
+	return s.TransferToAccountContext(context.Background(), sid, accountSid, requestOptions...)
+}
+
+// TransferToAccountContext performs the same call as TransferToAccount, bound to the given context.
+func (s *IncomingPhoneNumberService) TransferToAccountContext(ctx context.Context, sid, accountSid string, requestOptions ...option.RequestOption) (*IncomingPhoneNumber, error) {
+	if sid == "" || accountSid == "" {
+		return nil, ErrIncomingPhoneMissingData
+	}
+
+	values := url.Values{}
+	values.Set("AccountSid", accountSid)
+
+	return s.post(ctx, "/IncomingPhoneNumbers/"+sid+".json", requestOptions, values)
+}
+
+// Create provisions a new Incoming Phone Number, either a specific PhoneNumber
+// or any available number in the given AreaCode.
+// Doc: https://www.twilio.com/docs/api/rest/incoming-phone-numbers#list-post
+func (s *IncomingPhoneNumberService) Create(params *IncomingPhoneNumberParams, requestOptions ...option.RequestOption) (*IncomingPhoneNumber, error) {
+	return s.CreateContext(context.Background(), params, requestOptions...)
+}
+
+// CreateContext performs the same call as Create, bound to the given context.
+func (s *IncomingPhoneNumberService) CreateContext(ctx context.Context, params *IncomingPhoneNumberParams, requestOptions ...option.RequestOption) (*IncomingPhoneNumber, error) {
+	if params == nil || (params.PhoneNumber == "" && params.AreaCode == "") {
+		return nil, ErrIncomingPhoneMissingData
 	}
 
-	updates := url.Values{}
-	updates.Set("FriendlyName", incomingPhoneNumber.FriendlyName)
-	updates.Set("ApiVersion", incomingPhoneNumber.APIVersion)
-	updates.Set("VoiceUrl", incomingPhoneNumber.VoiceURL)
-	updates.Set("VoiceMethod", incomingPhoneNumber.VoiceMethod)
-	updates.Set("VoiceFallbackUrl", incomingPhoneNumber.VoiceFallbackURL)
-	updates.Set("VoiceFallbackMethod", incomingPhoneNumber.VoiceFallbackMethod)
-	updates.Set("VoiceCallerIdLookup", strconv.FormatBool(incomingPhoneNumber.VoiceCallerIDLookup))
-	updates.Set("VoiceApplicationSid", incomingPhoneNumber.VoiceApplicationSid)
-	updates.Set("StatusCallback", incomingPhoneNumber.StatusCallback)
-	updates.Set("StatusCallbackMethod", incomingPhoneNumber.StatusCallbackMethod)
-	updates.Set("SmsUrl", incomingPhoneNumber.SmsURL)
-	updates.Set("SmsMethod", incomingPhoneNumber.SmsMethod)
-	updates.Set("SmsFallbackUrl", incomingPhoneNumber.SmsFallbackURL)
-	updates.Set("SmsFallbackMethod", incomingPhoneNumber.SmsFallbackMethod)
-	updates.Set("AccountSid", incomingPhoneNumber.AccountSid)
-
-	body, err := s.Client.Post("/IncomingPhoneNumbers/"+incomingPhoneNumber.Sid+".json", requestOptions, updates)
+	return s.post(ctx, "/IncomingPhoneNumbers.json", requestOptions, params.values())
+}
+
+func (s *IncomingPhoneNumberService) post(ctx context.Context, uri string, requestOptions []option.RequestOption, values url.Values) (*IncomingPhoneNumber, error) {
+	body, err := s.Client.PostContext(ctx, uri, requestOptions, values)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	var updatedIncomingPhoneNumber *IncomingPhoneNumber
-	updatedIncomingPhoneNumber = new(IncomingPhoneNumber)
+	var incomingPhoneNumber IncomingPhoneNumber
 
-	err = json.Unmarshal(body, incomingPhoneNumber)
+	err = json.Unmarshal(body, &incomingPhoneNumber)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	incomingPhoneNumber = updatedIncomingPhoneNumber
+	return &incomingPhoneNumber, nil
+}
+
+// Delete releases an Incoming Phone Number from the account.
+// Doc: https://www.twilio.com/docs/api/rest/incoming-phone-numbers#instance-delete
+func (s *IncomingPhoneNumberService) Delete(sid string, requestOptions ...option.RequestOption) error {
+	return s.DeleteContext(context.Background(), sid, requestOptions...)
+}
+
+// DeleteContext performs the same call as Delete, bound to the given context.
+func (s *IncomingPhoneNumberService) DeleteContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) error {
+	if sid == "" {
+		return ErrIncomingPhoneMissingData
+	}
 
-	return nil
+	return s.Client.DeleteContext(ctx, "/IncomingPhoneNumbers/"+sid+".json", requestOptions)
 }
 
 // All retrieves all the incoming Phone Numbers of your account
 // Doc: https://www.twilio.com/docs/api/rest/incoming-phone-numbers#list-get
 func (s *IncomingPhoneNumberService) All() ([]*IncomingPhoneNumber, error) {
+	return s.AllContext(context.Background())
+}
 
+// AllContext performs the same calls as All, bound to the given context.
+func (s *IncomingPhoneNumberService) AllContext(ctx context.Context) ([]*IncomingPhoneNumber, error) {
 	phones := make([]*IncomingPhoneNumber, 0)
 
-	firstList, err := s.List(option.PageSize(200))
-	if err != nil {
-		return nil, err
+	it := s.IterContext(ctx, option.PageSize(200))
+	for it.Next() {
+		phones = append(phones, it.Value())
 	}
 
-	phones = firstList.IncomingPhoneNumbers
-	previousList := firstList
-
-	for {
-		nextPage, err := s.ListNextPage(previousList)
-		if err != nil {
-			if err == ErrIncomingPhoneListNoNextPage {
-				break
-			}
-			return nil, err
-		}
-
-		phones = append(phones, nextPage.IncomingPhoneNumbers...)
-		previousList = nextPage
+	if err := it.Err(); err != nil {
+		return nil, err
 	}
 
 	return phones, nil
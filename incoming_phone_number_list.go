@@ -1,8 +1,8 @@
 package twiliolo
 
 import (
+	"context"
 	"encoding/json"
-	"reflect"
 
 	"github.com/genesor/twiliolo/option"
 )
@@ -18,10 +18,16 @@ type IncomingPhoneNumberList struct {
 	IncomingPhoneNumbers []*IncomingPhoneNumber `json:"incoming_phone_numbers"`
 }
 
-// List retrieves the first page of all the Incoming Phone Number owned
+// List retrieves the first page of all the Incoming Phone Number owned,
+// filtered with the PhoneNumber, FriendlyName, Beta and Origin options.
 // Doc: https://www.twilio.com/docs/api/rest/incoming-phone-numbers#list-get
 func (s *IncomingPhoneNumberService) List(requestOptions ...option.RequestOption) (*IncomingPhoneNumberList, error) {
-	body, err := s.Client.Get("/IncomingPhoneNumbers.json", requestOptions)
+	return s.ListContext(context.Background(), requestOptions...)
+}
+
+// ListContext performs the same call as List, bound to the given context.
+func (s *IncomingPhoneNumberService) ListContext(ctx context.Context, requestOptions ...option.RequestOption) (*IncomingPhoneNumberList, error) {
+	body, err := s.Client.GetContext(ctx, "/IncomingPhoneNumbers.json", requestOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -33,26 +39,31 @@ func (s *IncomingPhoneNumberService) List(requestOptions ...option.RequestOption
 	return incomingPhoneNumberList, err
 }
 
-// ListNextPage retrieves the next page of a given IncomingPhoneNumberList
+// ListNextPage retrieves the next page of a given IncomingPhoneNumberList by following its NextPageURI.
+// The given options are added to the filters of the NextPageURI, except Page and PageSize which it already sets.
 // If an empty NextPageURI is present in the struct it'll return an error
 // Doc: https://www.twilio.com/docs/api/rest/incoming-phone-numbers#list-get
 func (s *IncomingPhoneNumberService) ListNextPage(previousList *IncomingPhoneNumberList, requestOptions ...option.RequestOption) (*IncomingPhoneNumberList, error) {
+	return s.ListNextPageContext(context.Background(), previousList, requestOptions...)
+}
+
+// ListNextPageContext performs the same call as ListNextPage, bound to the given context.
+func (s *IncomingPhoneNumberService) ListNextPageContext(ctx context.Context, previousList *IncomingPhoneNumberList, requestOptions ...option.RequestOption) (*IncomingPhoneNumberList, error) {
 	if previousList == nil || previousList.NextPageURI == "" {
-		return nil, ErrIncomingPhoneListNoNextPage
+		return nil, ErrNoNextPage
 	}
 
-	newRequestOptions := make([]option.RequestOption, 2)
-	newRequestOptions[0] = option.Page(previousList.Page + 1)
-	newRequestOptions[1] = option.PageSize(previousList.PageSize)
-
-	for _, option := range requestOptions {
-		// Override Page and PageSize options
-		if reflect.TypeOf(option).Name() != "Page" || reflect.TypeOf(option).Name() != "PageSize" {
-			newRequestOptions = append(newRequestOptions, option)
+	newRequestOptions := make([]option.RequestOption, 0, len(requestOptions))
+	for _, requestOption := range requestOptions {
+		switch requestOption.(type) {
+		case option.Page, option.PageSize:
+			// Already set by the NextPageURI
+		default:
+			newRequestOptions = append(newRequestOptions, requestOption)
 		}
 	}
 
-	body, err := s.Client.Get("/IncomingPhoneNumbers.json", newRequestOptions)
+	body, err := s.Client.GetContext(ctx, previousList.NextPageURI, newRequestOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -63,3 +74,72 @@ func (s *IncomingPhoneNumberService) ListNextPage(previousList *IncomingPhoneNum
 
 	return incomingPhoneNumberList, err
 }
+
+// Iter returns an Iterator over all the Incoming Phone Numbers matching the given options.
+func (s *IncomingPhoneNumberService) Iter(requestOptions ...option.RequestOption) *Iterator[*IncomingPhoneNumber] {
+	return s.IterContext(context.Background(), requestOptions...)
+}
+
+// IterContext performs the same calls as Iter, bound to the given context.
+func (s *IncomingPhoneNumberService) IterContext(ctx context.Context, requestOptions ...option.RequestOption) *Iterator[*IncomingPhoneNumber] {
+	return newIterator(ctx, s.Client, "/IncomingPhoneNumbers.json", requestOptions, func(body []byte) ([]*IncomingPhoneNumber, string, error) {
+		list := new(IncomingPhoneNumberList)
+		err := json.Unmarshal(body, list)
+
+		return list.IncomingPhoneNumbers, list.NextPageURI, err
+	})
+}
+
+// ListByType retrieves the first page of the Incoming Phone Numbers owned of the
+// given type, which can only be NumberTypeLocal, NumberTypeMobile or NumberTypeTollFree.
+// Doc: https://www.twilio.com/docs/phone-numbers/api/incomingphonenumber-local-resource
+func (s *IncomingPhoneNumberService) ListByType(numberType NumberType, requestOptions ...option.RequestOption) (*IncomingPhoneNumberList, error) {
+	return s.ListByTypeContext(context.Background(), numberType, requestOptions...)
+}
+
+// ListByTypeContext performs the same call as ListByType, bound to the given context.
+func (s *IncomingPhoneNumberService) ListByTypeContext(ctx context.Context, numberType NumberType, requestOptions ...option.RequestOption) (*IncomingPhoneNumberList, error) {
+	uri, err := incomingPhoneNumberTypeURI(numberType)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := s.Client.GetContext(ctx, uri, requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	incomingPhoneNumberList := new(IncomingPhoneNumberList)
+	err = json.Unmarshal(body, incomingPhoneNumberList)
+
+	return incomingPhoneNumberList, err
+}
+
+// IterByType returns an Iterator over all the Incoming Phone Numbers of the given type matching the given options.
+func (s *IncomingPhoneNumberService) IterByType(numberType NumberType, requestOptions ...option.RequestOption) *Iterator[*IncomingPhoneNumber] {
+	return s.IterByTypeContext(context.Background(), numberType, requestOptions...)
+}
+
+// IterByTypeContext performs the same calls as IterByType, bound to the given context.
+func (s *IncomingPhoneNumberService) IterByTypeContext(ctx context.Context, numberType NumberType, requestOptions ...option.RequestOption) *Iterator[*IncomingPhoneNumber] {
+	uri, err := incomingPhoneNumberTypeURI(numberType)
+
+	it := newIterator(ctx, s.Client, uri, requestOptions, func(body []byte) ([]*IncomingPhoneNumber, string, error) {
+		list := new(IncomingPhoneNumberList)
+		err := json.Unmarshal(body, list)
+
+		return list.IncomingPhoneNumbers, list.NextPageURI, err
+	})
+	it.err = err
+
+	return it
+}
+
+func incomingPhoneNumberTypeURI(numberType NumberType) (string, error) {
+	switch numberType {
+	case NumberTypeLocal, NumberTypeMobile, NumberTypeTollFree:
+		return "/IncomingPhoneNumbers/" + string(numberType) + ".json", nil
+	}
+
+	return "", ErrUnsupportedNumberType
+}
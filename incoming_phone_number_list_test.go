@@ -108,9 +108,8 @@ func TestIncomingPhoneNumberListNextPage(t *testing.T) {
 	t.Run("OK - GET next page", func(t *testing.T) {
 		client := new(internal.MockAPIClient)
 		client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
-			assert.Equal(t, "/IncomingPhoneNumbers.json", uri)
-			assert.Equal(t, option.Page(1), requestOptions[0])
-			assert.Equal(t, option.PageSize(94), requestOptions[1])
+			assert.Equal(t, "/2010-04-01/Accounts/TwilioloFake/IncomingPhoneNumbers.json?Page=1&PageSize=94", uri)
+			assert.Empty(t, requestOptions)
 
 			response := fmt.Sprintf(`
 			{
@@ -171,6 +170,24 @@ func TestIncomingPhoneNumberListNextPage(t *testing.T) {
 		assert.Equal(t, "/2010-04-01/Accounts/TwilioloFake/IncomingPhoneNumbers.json?Page=1&PageSize=94", list.URI)
 		assert.Equal(t, "TwiliololIncomingFake", list.IncomingPhoneNumbers[0].Sid)
 	})
+	t.Run("OK - Options added to the next page", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/2010-04-01/Accounts/TwilioloFake/IncomingPhoneNumbers.json?Page=1&PageSize=94", uri)
+			assert.Equal(t, []option.RequestOption{option.Beta(false)}, requestOptions)
+
+			return []byte(`{"page": 1, "page_size": 94, "incoming_phone_numbers": []}`), nil
+		}
+
+		service := twiliolo.IncomingPhoneNumberService{Client: client}
+		previousList := twiliolo.IncomingPhoneNumberList{
+			NextPageURI: "/2010-04-01/Accounts/TwilioloFake/IncomingPhoneNumbers.json?Page=1&PageSize=94",
+		}
+
+		list, err := service.ListNextPage(&previousList, option.Page(5), option.Beta(false), option.PageSize(10))
+		assert.NoError(t, err)
+		assert.Equal(t, 1, list.Page)
+	})
 	t.Run("NOK - No next page", func(t *testing.T) {
 		client := new(internal.MockAPIClient)
 
@@ -187,3 +204,66 @@ func TestIncomingPhoneNumberListNextPage(t *testing.T) {
 		assert.Nil(t, list)
 	})
 }
+
+func TestIncomingPhoneNumberListByType(t *testing.T) {
+	t.Run("OK - Mobile numbers filtered", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/IncomingPhoneNumbers/Mobile.json", uri)
+			assert.Equal(t, []option.RequestOption{option.PhoneNumber("555"), option.FriendlyName("Support"), option.Beta(false), option.Origin("twilio")}, requestOptions)
+
+			return []byte(`{"page": 0, "incoming_phone_numbers": [{"sid": "PNTwilioloFake", "phone_number": "+33655512345"}]}`), nil
+		}
+
+		service := twiliolo.IncomingPhoneNumberService{Client: client}
+		list, err := service.ListByType(twiliolo.NumberTypeMobile, option.PhoneNumber("555"), option.FriendlyName("Support"), option.Beta(false), option.Origin("twilio"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(list.IncomingPhoneNumbers))
+		assert.Equal(t, "+33655512345", list.IncomingPhoneNumbers[0].PhoneNumber)
+	})
+
+	t.Run("NOK - Unsupported number type", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.IncomingPhoneNumberService{Client: client}
+
+		list, err := service.ListByType(twiliolo.NumberTypeVoip)
+
+		assert.Equal(t, twiliolo.ErrUnsupportedNumberType, err)
+		assert.Nil(t, list)
+		assert.Equal(t, 0, client.GetCall)
+	})
+}
+
+func TestIncomingPhoneNumberIterByType(t *testing.T) {
+	t.Run("OK - Toll free numbers iterated", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/IncomingPhoneNumbers/TollFree.json", uri)
+
+			return []byte(`{"incoming_phone_numbers": [{"sid": "PNTwilioloFake"}, {"sid": "PNTwilioloFake2"}]}`), nil
+		}
+
+		service := twiliolo.IncomingPhoneNumberService{Client: client}
+		it := service.IterByType(twiliolo.NumberTypeTollFree)
+
+		sids := []string{}
+		for it.Next() {
+			sids = append(sids, it.Value().Sid)
+		}
+
+		assert.NoError(t, it.Err())
+		assert.Equal(t, []string{"PNTwilioloFake", "PNTwilioloFake2"}, sids)
+	})
+
+	t.Run("NOK - Unsupported number type", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.IncomingPhoneNumberService{Client: client}
+
+		it := service.IterByType(twiliolo.NumberTypeNational)
+
+		assert.False(t, it.Next())
+		assert.Equal(t, twiliolo.ErrUnsupportedNumberType, it.Err())
+		assert.Equal(t, 0, client.GetCall)
+	})
+}
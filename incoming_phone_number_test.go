@@ -1,6 +1,8 @@
 package twiliolo_test
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
@@ -14,8 +16,8 @@ import (
 )
 
 var (
-	dateCreated = time.Now().Add(-48 * time.Hour)
-	dateUpdated = time.Now().Add(-24 * time.Hour)
+	dateCreated = time.Now().Add(-48 * time.Hour).UTC().Truncate(time.Second)
+	dateUpdated = time.Now().Add(-24 * time.Hour).UTC().Truncate(time.Second)
 	testNumber  = twiliolo.IncomingPhoneNumber{
 		Sid:                  "TwiliololIncomingFake",
 		AccountSid:           "TwilioloFake",
@@ -33,8 +35,8 @@ var (
 		SmsMethod:            "GET",
 		SmsFallbackURL:       "http://fail-sms.com",
 		SmsFallbackMethod:    "GET",
-		DateCreated:          dateCreated.Format(time.RFC1123Z),
-		DateUpdated:          dateUpdated.Format(time.RFC1123Z),
+		DateCreated:          dateCreated,
+		DateUpdated:          dateUpdated,
 		Capabilities:         twiliolo.Capabilities{MMS: false, SMS: false, Voice: true},
 		APIVersion:           twiliolo.VERSION,
 		URI:                  "/2010-04-01/Accounts/TwilioloFake/IncomingPhoneNumbers/TwiliololIncomingFake.json",
@@ -43,27 +45,29 @@ var (
 
 func TestIncomingPhoneNumberUpdate(t *testing.T) {
 	t.Run("OK - Success update", func(t *testing.T) {
-		phoneNumber := testNumber
-		phoneNumber.FriendlyName = "New Friendly Name"
-		newUpdated := time.Now().Format(time.RFC1123Z)
+		newUpdated := time.Now().UTC().Truncate(time.Second)
 
 		client := new(internal.MockAPIClient)
 		client.PostFn = func(uri string, _ []option.RequestOption, params url.Values) ([]byte, error) {
 			assert.Equal(t, "/IncomingPhoneNumbers/TwiliololIncomingFake.json", uri)
-			assert.Equal(t, "New Friendly Name", params.Get("FriendlyName"))
+			assert.Equal(t, url.Values{
+				"FriendlyName":        []string{"New Friendly Name"},
+				"VoiceFallbackUrl":    []string{""},
+				"VoiceCallerIdLookup": []string{"false"},
+			}, params)
 			response := fmt.Sprintf(`
 			{
 				"sid": "TwiliololIncomingFake",
 				"account_sid": "TwilioloFake",
-				"friendly_name": "Twiliolo Test Number",
+				"friendly_name": "New Friendly Name",
 				"phone_number": "+33912345678",
 				"voice_url": "http://test.com",
 				"voice_method": "POST",
-				"voice_fallback_url": "http://fail.com",
+				"voice_fallback_url": "",
 				"voice_fallback_method": "GET",
 				"status_callback": "http://status.com",
 				"status_callback_method": "GET",
-				"voice_caller_id_lookup": true,
+				"voice_caller_id_lookup": false,
 				"voice_application_sid": null,
 				"date_created": "%s",
 				"date_updated": "%s",
@@ -80,30 +84,33 @@ func TestIncomingPhoneNumberUpdate(t *testing.T) {
 				"beta": false,
 				"api_version": "2010-04-01",
 				"uri": "\/2010-04-01\/Accounts\/TwilioloFake\/IncomingPhoneNumbers\/TwiliololIncomingFake.json"
-			}`, dateCreated.Format(time.RFC1123Z), newUpdated)
+			}`, dateCreated.Format(time.RFC1123Z), newUpdated.Format(time.RFC1123Z))
 
 			return []byte(response), nil
 		}
 
 		service := twiliolo.IncomingPhoneNumberService{Client: client}
 
-		err := service.Update(&phoneNumber)
+		phoneNumber, err := service.Update("TwiliololIncomingFake", &twiliolo.IncomingPhoneNumberUpdate{
+			FriendlyName:        twiliolo.String("New Friendly Name"),
+			VoiceFallbackURL:    twiliolo.String(""),
+			VoiceCallerIDLookup: twiliolo.Bool(false),
+		})
 		assert.NoError(t, err)
+		assert.Equal(t, "New Friendly Name", phoneNumber.FriendlyName)
+		assert.Equal(t, "http://test.com", phoneNumber.VoiceURL)
 		assert.Equal(t, newUpdated, phoneNumber.DateUpdated)
 	})
 
 	t.Run("NOK - Missing ID", func(t *testing.T) {
-		phoneNumber := twiliolo.IncomingPhoneNumber{
-			FriendlyName: "I am invalid",
-		}
-
 		client := new(internal.MockAPIClient)
 		service := twiliolo.IncomingPhoneNumberService{Client: client}
 
-		err := service.Update(&phoneNumber)
+		phoneNumber, err := service.Update("", &twiliolo.IncomingPhoneNumberUpdate{FriendlyName: twiliolo.String("I am invalid")})
 
 		assert.Error(t, err)
 		assert.Equal(t, twiliolo.ErrIncomingPhoneMissingData, err)
+		assert.Nil(t, phoneNumber)
 	})
 
 	t.Run("NOK - Error on API call", func(t *testing.T) {
@@ -115,13 +122,42 @@ func TestIncomingPhoneNumberUpdate(t *testing.T) {
 		}
 
 		service := twiliolo.IncomingPhoneNumberService{Client: client}
-		err := service.Update(&testNumber)
+		_, err := service.Update(testNumber.Sid, &twiliolo.IncomingPhoneNumberUpdate{})
 
 		assert.Error(t, err)
 		assert.EqualError(t, err, "Error in API")
 	})
 }
 
+func TestIncomingPhoneNumberTransferToAccount(t *testing.T) {
+	t.Run("OK - Number transferred", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.PostFn = func(uri string, _ []option.RequestOption, params url.Values) ([]byte, error) {
+			assert.Equal(t, "/IncomingPhoneNumbers/TwiliololIncomingFake.json", uri)
+			assert.Equal(t, url.Values{"AccountSid": []string{"ACSubFake"}}, params)
+
+			return []byte(`{"sid": "TwiliololIncomingFake", "account_sid": "ACSubFake"}`), nil
+		}
+
+		service := twiliolo.IncomingPhoneNumberService{Client: client}
+		phoneNumber, err := service.TransferToAccount("TwiliololIncomingFake", "ACSubFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ACSubFake", phoneNumber.AccountSid)
+	})
+
+	t.Run("NOK - Missing account", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.IncomingPhoneNumberService{Client: client}
+
+		phoneNumber, err := service.TransferToAccount("TwiliololIncomingFake", "")
+
+		assert.Equal(t, twiliolo.ErrIncomingPhoneMissingData, err)
+		assert.Nil(t, phoneNumber)
+		assert.Equal(t, 0, client.PostCall)
+	})
+}
+
 func TestIncomingPhoneNumberGet(t *testing.T) {
 	client := new(internal.MockAPIClient)
 	client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
@@ -168,13 +204,31 @@ func TestIncomingPhoneNumberGet(t *testing.T) {
 	assert.Equal(t, testNumber, *number)
 }
 
+func TestIncomingPhoneNumberGetContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client := new(internal.MockAPIClient)
+	client.GetContextFn = func(reqCtx context.Context, uri string, _ []option.RequestOption) ([]byte, error) {
+		assert.Equal(t, ctx, reqCtx)
+		assert.Equal(t, "/IncomingPhoneNumbers/TwiliololIncomingFake.json", uri)
+
+		return nil, reqCtx.Err()
+	}
+
+	service := twiliolo.IncomingPhoneNumberService{Client: client}
+	number, err := service.GetContext(ctx, "TwiliololIncomingFake")
+
+	assert.Equal(t, context.Canceled, err)
+	assert.Nil(t, number)
+	assert.Equal(t, 1, client.GetCall)
+}
+
 func TestIncomingPhoneNumberAll(t *testing.T) {
 	client := new(internal.MockAPIClient)
 	client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
-		assert.Equal(t, "/IncomingPhoneNumbers.json", uri)
-
-		if len(requestOptions) == 1 {
-			assert.Equal(t, option.PageSize(200), requestOptions[0])
+		if uri == "/IncomingPhoneNumbers.json" {
+			assert.Equal(t, []option.RequestOption{option.PageSize(200)}, requestOptions)
 
 			response := fmt.Sprintf(`
 			{
@@ -216,8 +270,8 @@ func TestIncomingPhoneNumberAll(t *testing.T) {
 			}`, dateCreatedNumber.Format(time.RFC1123Z), dateUpdatedNumber.Format(time.RFC1123Z))
 
 			return []byte(response), nil
-		} else if len(requestOptions) == 2 && requestOptions[0] == option.Page(1) {
-			assert.Equal(t, option.PageSize(200), requestOptions[1])
+		} else if uri == "/2010-04-01/Accounts/TwilioloFake/IncomingPhoneNumbers.json?Page=1&PageSize=200" {
+			assert.Empty(t, requestOptions)
 
 			response := fmt.Sprintf(`
 			{
@@ -259,8 +313,8 @@ func TestIncomingPhoneNumberAll(t *testing.T) {
 			}`, dateCreatedNumber.Format(time.RFC1123Z), dateUpdatedNumber.Format(time.RFC1123Z))
 
 			return []byte(response), nil
-		} else if len(requestOptions) == 2 && requestOptions[0] == option.Page(2) {
-			assert.Equal(t, option.PageSize(200), requestOptions[1])
+		} else if uri == "/2010-04-01/Accounts/TwilioloFake/IncomingPhoneNumbers.json?Page=2&PageSize=200" {
+			assert.Empty(t, requestOptions)
 
 			response := fmt.Sprintf(`
 			{
@@ -316,3 +370,203 @@ func TestIncomingPhoneNumberAll(t *testing.T) {
 	assert.Equal(t, 3, len(phones))
 	assert.Equal(t, "TwiliololIncomingFake3", phones[2].Sid)
 }
+
+func TestIncomingPhoneNumberCreate(t *testing.T) {
+	t.Run("OK - Create in area code", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.PostFn = func(uri string, _ []option.RequestOption, values url.Values) ([]byte, error) {
+			assert.Equal(t, "/IncomingPhoneNumbers.json", uri)
+			assert.Equal(t, url.Values{
+				"AreaCode":            []string{"510"},
+				"FriendlyName":        []string{"Twiliolo Test Number"},
+				"VoiceUrl":            []string{"http://test.com"},
+				"VoiceMethod":         []string{"POST"},
+				"VoiceCallerIdLookup": []string{"true"},
+				"StatusCallback":      []string{"http://status.com"},
+				"SmsUrl":              []string{"http://sms.com"},
+			}, values)
+
+			return []byte(`{"sid": "TwiliololIncomingFake", "phone_number": "+15105555555", "friendly_name": "Twiliolo Test Number"}`), nil
+		}
+
+		service := twiliolo.IncomingPhoneNumberService{Client: client}
+		number, err := service.Create(&twiliolo.IncomingPhoneNumberParams{
+			AreaCode:            "510",
+			FriendlyName:        "Twiliolo Test Number",
+			VoiceURL:            "http://test.com",
+			VoiceMethod:         "POST",
+			VoiceCallerIDLookup: true,
+			StatusCallback:      "http://status.com",
+			SmsURL:              "http://sms.com",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "TwiliololIncomingFake", number.Sid)
+		assert.Equal(t, "+15105555555", number.PhoneNumber)
+	})
+
+	t.Run("NOK - Missing number and area code", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.IncomingPhoneNumberService{Client: client}
+
+		number, err := service.Create(&twiliolo.IncomingPhoneNumberParams{FriendlyName: "Twiliolo Test Number"})
+
+		assert.Equal(t, twiliolo.ErrIncomingPhoneMissingData, err)
+		assert.Nil(t, number)
+		assert.Equal(t, 0, client.PostCall)
+	})
+}
+
+func TestIncomingPhoneNumberDelete(t *testing.T) {
+	t.Run("OK - Release number", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.DeleteFn = func(uri string, _ []option.RequestOption) error {
+			assert.Equal(t, "/IncomingPhoneNumbers/TwiliololIncomingFake.json", uri)
+
+			return nil
+		}
+
+		service := twiliolo.IncomingPhoneNumberService{Client: client}
+		err := service.Delete("TwiliololIncomingFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, client.DeleteCall)
+	})
+
+	t.Run("NOK - Missing Sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.IncomingPhoneNumberService{Client: client}
+
+		err := service.Delete("")
+
+		assert.Equal(t, twiliolo.ErrIncomingPhoneMissingData, err)
+		assert.Equal(t, 0, client.DeleteCall)
+	})
+}
+
+func TestIncomingPhoneNumberUnmarshalJSON(t *testing.T) {
+	t.Run("OK - Full resource decoded", func(t *testing.T) {
+		var number twiliolo.IncomingPhoneNumber
+
+		err := json.Unmarshal([]byte(`
+		{
+			"sid": "TwiliololIncomingFake",
+			"address_sid": "ADTwilioloFake",
+			"address_requirements": "local",
+			"identity_sid": "RITwilioloFake",
+			"bundle_sid": "BUTwilioloFake",
+			"emergency_status": "Active",
+			"emergency_address_sid": "ADTwilioloEmergency",
+			"trunk_sid": null,
+			"voice_receive_mode": "fax",
+			"origin": "hosted",
+			"status": "in-use",
+			"date_created": "Mon, 16 Aug 2010 03:45:01 +0200",
+			"date_updated": null
+		}`), &number)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ADTwilioloFake", number.AddressSid)
+		assert.Equal(t, twiliolo.AddressRequirementLocal, number.AddressRequirements)
+		assert.Equal(t, "RITwilioloFake", number.IdentitySid)
+		assert.Equal(t, "BUTwilioloFake", number.BundleSid)
+		assert.Equal(t, twiliolo.EmergencyStatusActive, number.EmergencyStatus)
+		assert.Equal(t, "ADTwilioloEmergency", number.EmergencyAddressSid)
+		assert.Equal(t, "", number.TrunkSid)
+		assert.Equal(t, twiliolo.VoiceReceiveModeFax, number.VoiceReceiveMode)
+		assert.Equal(t, twiliolo.OriginHosted, number.Origin)
+		assert.Equal(t, twiliolo.IncomingPhoneNumberStatusInUse, number.Status)
+		assert.Equal(t, time.Date(2010, time.August, 16, 1, 45, 1, 0, time.UTC), number.DateCreated)
+		assert.True(t, number.DateUpdated.IsZero())
+	})
+
+	t.Run("NOK - Invalid date", func(t *testing.T) {
+		var number twiliolo.IncomingPhoneNumber
+
+		err := json.Unmarshal([]byte(`{"sid": "TwiliololIncomingFake", "date_created": "2010-08-16"}`), &number)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestIncomingPhoneNumberMarshalJSON(t *testing.T) {
+	t.Run("OK - Round trip", func(t *testing.T) {
+		number := twiliolo.IncomingPhoneNumber{
+			Sid:              "TwiliololIncomingFake",
+			PhoneNumber:      "+33912345678",
+			VoiceReceiveMode: twiliolo.VoiceReceiveModeVoice,
+			DateCreated:      time.Date(2010, time.August, 16, 23, 0, 23, 0, time.UTC),
+		}
+
+		data, err := json.Marshal(number)
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), `"date_created":"Mon, 16 Aug 2010 23:00:23 +0000"`)
+		assert.Contains(t, string(data), `"date_updated":""`)
+
+		var decoded twiliolo.IncomingPhoneNumber
+
+		err = json.Unmarshal(data, &decoded)
+		assert.NoError(t, err)
+		assert.Equal(t, number, decoded)
+	})
+
+	t.Run("OK - Pointer marshalled with RFC 2822 dates", func(t *testing.T) {
+		data, err := json.Marshal(&twiliolo.IncomingPhoneNumber{DateUpdated: time.Date(2010, time.August, 16, 23, 0, 23, 0, time.UTC)})
+
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), `"date_updated":"Mon, 16 Aug 2010 23:00:23 +0000"`)
+	})
+}
+
+func TestIncomingPhoneNumberExtendedSettings(t *testing.T) {
+	t.Run("OK - Set on create", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.PostFn = func(uri string, _ []option.RequestOption, params url.Values) ([]byte, error) {
+			assert.Equal(t, "/IncomingPhoneNumbers.json", uri)
+			assert.Equal(t, "fax", params.Get("VoiceReceiveMode"))
+			assert.Equal(t, "Active", params.Get("EmergencyStatus"))
+			assert.Equal(t, "ADTwilioloEmergency", params.Get("EmergencyAddressSid"))
+			assert.Equal(t, "BUTwilioloFake", params.Get("BundleSid"))
+			assert.NotContains(t, params, "TrunkSid")
+
+			return []byte(`{"sid": "TwiliololIncomingFake", "voice_receive_mode": "fax"}`), nil
+		}
+
+		service := twiliolo.IncomingPhoneNumberService{Client: client}
+		number, err := service.Create(&twiliolo.IncomingPhoneNumberParams{
+			PhoneNumber:         "+33912345678",
+			VoiceReceiveMode:    twiliolo.VoiceReceiveModeFax,
+			EmergencyStatus:     twiliolo.EmergencyStatusActive,
+			EmergencyAddressSid: "ADTwilioloEmergency",
+			BundleSid:           "BUTwilioloFake",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, twiliolo.VoiceReceiveModeFax, number.VoiceReceiveMode)
+	})
+
+	t.Run("OK - Set and cleared on update", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.PostFn = func(uri string, _ []option.RequestOption, params url.Values) ([]byte, error) {
+			assert.Equal(t, url.Values{
+				"VoiceReceiveMode": []string{"voice"},
+				"EmergencyStatus":  []string{"Inactive"},
+				"IdentitySid":      []string{"RITwilioloFake"},
+				"TrunkSid":         []string{""},
+			}, params)
+
+			return []byte(`{"sid": "TwiliololIncomingFake", "emergency_status": "Inactive"}`), nil
+		}
+
+		service := twiliolo.IncomingPhoneNumberService{Client: client}
+		number, err := service.Update("TwiliololIncomingFake", &twiliolo.IncomingPhoneNumberUpdate{
+			VoiceReceiveMode: twiliolo.VoiceReceiveModeVoice,
+			EmergencyStatus:  twiliolo.EmergencyStatusInactive,
+			IdentitySid:      twiliolo.String("RITwilioloFake"),
+			TrunkSid:         twiliolo.String(""),
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, twiliolo.EmergencyStatusInactive, number.EmergencyStatus)
+	})
+}
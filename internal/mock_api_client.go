@@ -1,38 +1,91 @@
 package internal
 
 import (
+	"context"
+	"io"
 	"net/url"
 
 	"github.com/genesor/twiliolo/option"
 )
 
 // MockAPIClient is the mocked implementation of the APIClient interface.
+// The context-aware methods fall back on the non-context functions when
+// their own function is not set, so both can share the same call counters.
 type MockAPIClient struct {
-	GetCall    int
-	GetFn      func(string, []option.RequestOption) ([]byte, error)
-	PostCall   int
-	PostFn     func(string, []option.RequestOption, url.Values) ([]byte, error)
-	DeleteCall int
-	DeleteFn   func(string, []option.RequestOption) error
+	GetCall           int
+	GetFn             func(string, []option.RequestOption) ([]byte, error)
+	GetContextFn      func(context.Context, string, []option.RequestOption) ([]byte, error)
+	PostCall          int
+	PostFn            func(string, []option.RequestOption, url.Values) ([]byte, error)
+	PostContextFn     func(context.Context, string, []option.RequestOption, url.Values) ([]byte, error)
+	DeleteCall        int
+	DeleteFn          func(string, []option.RequestOption) error
+	DeleteContextFn   func(context.Context, string, []option.RequestOption) error
+	DownloadCall      int
+	DownloadFn        func(string, []option.RequestOption, io.Writer) error
+	DownloadContextFn func(context.Context, string, []option.RequestOption, io.Writer) error
 }
 
 // Get mocked function.
 func (c *MockAPIClient) Get(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+	return c.GetContext(context.Background(), uri, requestOptions)
+}
+
+// GetContext mocked function.
+func (c *MockAPIClient) GetContext(ctx context.Context, uri string, requestOptions []option.RequestOption) ([]byte, error) {
 	c.GetCall++
 
+	if c.GetContextFn != nil {
+		return c.GetContextFn(ctx, uri, requestOptions)
+	}
+
 	return c.GetFn(uri, requestOptions)
 }
 
 // Post mocked function.
 func (c *MockAPIClient) Post(uri string, requestOptions []option.RequestOption, updates url.Values) ([]byte, error) {
+	return c.PostContext(context.Background(), uri, requestOptions, updates)
+}
+
+// PostContext mocked function.
+func (c *MockAPIClient) PostContext(ctx context.Context, uri string, requestOptions []option.RequestOption, updates url.Values) ([]byte, error) {
 	c.PostCall++
 
+	if c.PostContextFn != nil {
+		return c.PostContextFn(ctx, uri, requestOptions, updates)
+	}
+
 	return c.PostFn(uri, requestOptions, updates)
 }
 
 // Delete mocked function.
 func (c *MockAPIClient) Delete(uri string, requestOptions []option.RequestOption) error {
+	return c.DeleteContext(context.Background(), uri, requestOptions)
+}
+
+// DeleteContext mocked function.
+func (c *MockAPIClient) DeleteContext(ctx context.Context, uri string, requestOptions []option.RequestOption) error {
 	c.DeleteCall++
 
+	if c.DeleteContextFn != nil {
+		return c.DeleteContextFn(ctx, uri, requestOptions)
+	}
+
 	return c.DeleteFn(uri, requestOptions)
 }
+
+// Download mocked function.
+func (c *MockAPIClient) Download(uri string, requestOptions []option.RequestOption, w io.Writer) error {
+	return c.DownloadContext(context.Background(), uri, requestOptions, w)
+}
+
+// DownloadContext mocked function.
+func (c *MockAPIClient) DownloadContext(ctx context.Context, uri string, requestOptions []option.RequestOption, w io.Writer) error {
+	c.DownloadCall++
+
+	if c.DownloadContextFn != nil {
+		return c.DownloadContextFn(ctx, uri, requestOptions, w)
+	}
+
+	return c.DownloadFn(uri, requestOptions, w)
+}
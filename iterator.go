@@ -0,0 +1,111 @@
+package twiliolo
+
+import (
+	"context"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// Iterator walks through every item of a Twilio list, fetching the pages
+// lazily by following the next_page_uri returned with each of them.
+//
+//	it := client.Message.Iter(option.To("+33612345678"))
+//	for it.Next() {
+//		message := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle error
+//	}
+type Iterator[T any] struct {
+	ctx            context.Context
+	client         APIClient
+	uri            string
+	requestOptions []option.RequestOption
+	decode         func([]byte) ([]T, string, error)
+
+	items   []T
+	current T
+	count   int
+	limit   int
+	err     error
+}
+
+// newIterator instanciates an Iterator starting from the given list URI.
+// decode extracts the items and the next page URI from a page body.
+func newIterator[T any](ctx context.Context, client APIClient, uri string, requestOptions []option.RequestOption, decode func([]byte) ([]T, string, error)) *Iterator[T] {
+	return &Iterator[T]{
+		ctx:            ctx,
+		client:         client,
+		uri:            uri,
+		requestOptions: requestOptions,
+		decode:         decode,
+	}
+}
+
+// NewSliceIterator instanciates an Iterator over the given items, without
+// any API call. It is mostly useful to mock the Iter methods of the services.
+func NewSliceIterator[T any](items []T) *Iterator[T] {
+	return &Iterator[T]{items: items}
+}
+
+// Limit sets the maximum number of items returned by the Iterator, 0 meaning no limit.
+func (it *Iterator[T]) Limit(limit int) *Iterator[T] {
+	it.limit = limit
+
+	return it
+}
+
+// Next advances the Iterator to the next item, fetching the next page when
+// needed. It returns false when the list is over, the limit is reached or
+// an error occurred.
+func (it *Iterator[T]) Next() bool {
+	if it.err != nil || (it.limit > 0 && it.count >= it.limit) {
+		return false
+	}
+
+	for len(it.items) == 0 {
+		if it.uri == "" {
+			return false
+		}
+
+		if err := it.fetch(); err != nil {
+			it.err = err
+			return false
+		}
+	}
+
+	it.current = it.items[0]
+	it.items = it.items[1:]
+	it.count++
+
+	return true
+}
+
+// Value returns the current item.
+func (it *Iterator[T]) Value() T {
+	return it.current
+}
+
+// Err returns the error which stopped the Iterator, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+func (it *Iterator[T]) fetch() error {
+	body, err := it.client.GetContext(it.ctx, it.uri, it.requestOptions)
+	if err != nil {
+		return err
+	}
+
+	items, nextPageURI, err := it.decode(body)
+	if err != nil {
+		return err
+	}
+
+	it.items = items
+	// The next page URI already contains the filters of the first request.
+	it.requestOptions = nil
+	it.uri = nextPageURI
+
+	return nil
+}
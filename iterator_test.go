@@ -0,0 +1,96 @@
+package twiliolo_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func newPagedMockAPIClient(t *testing.T) *internal.MockAPIClient {
+	client := new(internal.MockAPIClient)
+	client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+		switch uri {
+		case "/Messages.json":
+			assert.Equal(t, []option.RequestOption{option.From("+33612345678")}, requestOptions)
+
+			return []byte(`{
+				"next_page_uri": "\/2010-04-01\/Accounts\/TwilioloFake\/Messages.json?From=%2B33612345678&Page=1&PageToken=PA2",
+				"messages": [{"sid": "SM1"}, {"sid": "SM2"}]
+			}`), nil
+		case "/2010-04-01/Accounts/TwilioloFake/Messages.json?From=%2B33612345678&Page=1&PageToken=PA2":
+			assert.Empty(t, requestOptions)
+
+			return []byte(`{
+				"next_page_uri": "\/2010-04-01\/Accounts\/TwilioloFake\/Messages.json?From=%2B33612345678&Page=2&PageToken=PA3",
+				"messages": []
+			}`), nil
+		case "/2010-04-01/Accounts/TwilioloFake/Messages.json?From=%2B33612345678&Page=2&PageToken=PA3":
+			return []byte(`{"next_page_uri": null, "messages": [{"sid": "SM3"}]}`), nil
+		}
+
+		return nil, errors.New("Unknown call")
+	}
+
+	return client
+}
+
+func TestIterator(t *testing.T) {
+	t.Run("OK - Follow every page", func(t *testing.T) {
+		client := newPagedMockAPIClient(t)
+		service := twiliolo.MessageService{Client: client}
+
+		sids := make([]string, 0)
+		it := service.Iter(option.From("+33612345678"))
+		for it.Next() {
+			sids = append(sids, it.Value().Sid)
+		}
+
+		assert.NoError(t, it.Err())
+		assert.Equal(t, []string{"SM1", "SM2", "SM3"}, sids)
+		assert.Equal(t, 3, client.GetCall)
+		assert.False(t, it.Next())
+	})
+
+	t.Run("OK - Limit stops fetching pages", func(t *testing.T) {
+		client := newPagedMockAPIClient(t)
+		service := twiliolo.MessageService{Client: client}
+
+		sids := make([]string, 0)
+		it := service.Iter(option.From("+33612345678")).Limit(2)
+		for it.Next() {
+			sids = append(sids, it.Value().Sid)
+		}
+
+		assert.NoError(t, it.Err())
+		assert.Equal(t, []string{"SM1", "SM2"}, sids)
+		assert.Equal(t, 1, client.GetCall)
+	})
+
+	t.Run("NOK - Error on API call", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+			return nil, errors.New("Error in API")
+		}
+
+		service := twiliolo.CallService{Client: client}
+		it := service.Iter()
+
+		assert.False(t, it.Next())
+		assert.EqualError(t, it.Err(), "Error in API")
+	})
+
+	t.Run("OK - Slice iterator", func(t *testing.T) {
+		it := twiliolo.NewSliceIterator([]string{"a", "b"})
+
+		assert.True(t, it.Next())
+		assert.Equal(t, "a", it.Value())
+		assert.True(t, it.Next())
+		assert.Equal(t, "b", it.Value())
+		assert.False(t, it.Next())
+		assert.NoError(t, it.Err())
+	})
+}
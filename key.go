@@ -0,0 +1,144 @@
+package twiliolo
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// KeyServiceInterface is the interface of a KeyService
+type KeyServiceInterface interface {
+	Create(string, ...option.RequestOption) (*Key, error)
+	Get(string, ...option.RequestOption) (*Key, error)
+	Delete(string, ...option.RequestOption) error
+	List(...option.RequestOption) (*KeyList, error)
+	ListNextPage(*KeyList) (*KeyList, error)
+	Iter(...option.RequestOption) *Iterator[*Key]
+	CreateContext(context.Context, string, ...option.RequestOption) (*Key, error)
+	GetContext(context.Context, string, ...option.RequestOption) (*Key, error)
+	DeleteContext(context.Context, string, ...option.RequestOption) error
+	ListContext(context.Context, ...option.RequestOption) (*KeyList, error)
+	ListNextPageContext(context.Context, *KeyList) (*KeyList, error)
+	IterContext(context.Context, ...option.RequestOption) *Iterator[*Key]
+}
+
+// KeyService handles communication with the API Key related methods.
+type KeyService service
+
+// Key represents a Twilio API Key, its Secret is only returned on creation.
+type Key struct {
+	Sid          string    `json:"sid"`
+	FriendlyName string    `json:"friendly_name"`
+	Secret       string    `json:"secret"`
+	DateCreated  time.Time `json:"date_created"`
+	DateUpdated  time.Time `json:"date_updated"`
+}
+
+// UnmarshalJSON decodes a Key, parsing its RFC 2822 dates.
+func (k *Key) UnmarshalJSON(data []byte) error {
+	type key Key
+
+	raw := struct {
+		*key
+		DateCreated string `json:"date_created"`
+		DateUpdated string `json:"date_updated"`
+	}{key: (*key)(k)}
+
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	k.DateCreated, err = parseDate(raw.DateCreated)
+	if err != nil {
+		return err
+	}
+
+	k.DateUpdated, err = parseDate(raw.DateUpdated)
+
+	return err
+}
+
+// MarshalJSON encodes a Key, formatting its dates in RFC 2822 like Twilio.
+func (k Key) MarshalJSON() ([]byte, error) {
+	type key Key
+
+	return json.Marshal(struct {
+		key
+		DateCreated string `json:"date_created"`
+		DateUpdated string `json:"date_updated"`
+	}{
+		key:         key(k),
+		DateCreated: formatDate(k.DateCreated),
+		DateUpdated: formatDate(k.DateUpdated),
+	})
+}
+
+// Create creates a new standard API Key.
+// Doc: https://www.twilio.com/docs/iam/keys/api-key-resource#create-a-new-api-key
+func (s *KeyService) Create(friendlyName string, requestOptions ...option.RequestOption) (*Key, error) {
+	return s.CreateContext(context.Background(), friendlyName, requestOptions...)
+}
+
+// CreateContext performs the same call as Create, bound to the given context.
+func (s *KeyService) CreateContext(ctx context.Context, friendlyName string, requestOptions ...option.RequestOption) (*Key, error) {
+	values := url.Values{}
+	if friendlyName != "" {
+		values.Set("FriendlyName", friendlyName)
+	}
+
+	body, err := s.Client.PostContext(ctx, "/Keys.json", requestOptions, values)
+	if err != nil {
+		return nil, err
+	}
+
+	var key Key
+
+	err = json.Unmarshal(body, &key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// Get performs a call to the twilio API to retrieve an API Key with its Sid.
+// Doc: https://www.twilio.com/docs/iam/keys/api-key-resource#fetch-an-api-key
+func (s *KeyService) Get(sid string, requestOptions ...option.RequestOption) (*Key, error) {
+	return s.GetContext(context.Background(), sid, requestOptions...)
+}
+
+// GetContext performs the same call as Get, bound to the given context.
+func (s *KeyService) GetContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*Key, error) {
+	if sid == "" {
+		return nil, ErrKeyMissingData
+	}
+
+	res, err := s.Client.GetContext(ctx, "/Keys/"+sid+".json", requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	key := new(Key)
+	err = json.Unmarshal(res, key)
+
+	return key, err
+}
+
+// Delete revokes an API Key, requests authenticated with it are rejected afterwards.
+// Doc: https://www.twilio.com/docs/iam/keys/api-key-resource#delete-an-api-key
+func (s *KeyService) Delete(sid string, requestOptions ...option.RequestOption) error {
+	return s.DeleteContext(context.Background(), sid, requestOptions...)
+}
+
+// DeleteContext performs the same call as Delete, bound to the given context.
+func (s *KeyService) DeleteContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) error {
+	if sid == "" {
+		return ErrKeyMissingData
+	}
+
+	return s.Client.DeleteContext(ctx, "/Keys/"+sid+".json", requestOptions)
+}
@@ -0,0 +1,76 @@
+package twiliolo
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// KeyList represents the response of the Twilio API when calling /Keys.json
+type KeyList struct {
+	Page            int    `json:"page"`
+	PageSize        int    `json:"page_size"`
+	URI             string `json:"uri"`
+	FirstPageURI    string `json:"first_page_uri"`
+	NextPageURI     string `json:"next_page_uri"`
+	PreviousPageURI string `json:"previous_page_uri"`
+	Keys            []*Key `json:"keys"`
+}
+
+// List retrieves the first page of the API Keys.
+// Doc: https://www.twilio.com/docs/iam/keys/api-key-resource#read-multiple-api-keys
+func (s *KeyService) List(requestOptions ...option.RequestOption) (*KeyList, error) {
+	return s.ListContext(context.Background(), requestOptions...)
+}
+
+// ListContext performs the same call as List, bound to the given context.
+func (s *KeyService) ListContext(ctx context.Context, requestOptions ...option.RequestOption) (*KeyList, error) {
+	body, err := s.Client.GetContext(ctx, "/Keys.json", requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	keyList := new(KeyList)
+	err = json.Unmarshal(body, keyList)
+
+	return keyList, err
+}
+
+// ListNextPage retrieves the next page of a given KeyList by following its NextPageURI.
+// If an empty NextPageURI is present in the struct it'll return an error
+func (s *KeyService) ListNextPage(previousList *KeyList) (*KeyList, error) {
+	return s.ListNextPageContext(context.Background(), previousList)
+}
+
+// ListNextPageContext performs the same call as ListNextPage, bound to the given context.
+func (s *KeyService) ListNextPageContext(ctx context.Context, previousList *KeyList) (*KeyList, error) {
+	if previousList == nil || previousList.NextPageURI == "" {
+		return nil, ErrNoNextPage
+	}
+
+	body, err := s.Client.GetContext(ctx, previousList.NextPageURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	keyList := new(KeyList)
+	err = json.Unmarshal(body, keyList)
+
+	return keyList, err
+}
+
+// Iter returns an Iterator over all the API Keys matching the given options.
+func (s *KeyService) Iter(requestOptions ...option.RequestOption) *Iterator[*Key] {
+	return s.IterContext(context.Background(), requestOptions...)
+}
+
+// IterContext performs the same calls as Iter, bound to the given context.
+func (s *KeyService) IterContext(ctx context.Context, requestOptions ...option.RequestOption) *Iterator[*Key] {
+	return newIterator(ctx, s.Client, "/Keys.json", requestOptions, func(body []byte) ([]*Key, string, error) {
+		list := new(KeyList)
+		err := json.Unmarshal(body, list)
+
+		return list.Keys, list.NextPageURI, err
+	})
+}
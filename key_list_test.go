@@ -0,0 +1,59 @@
+package twiliolo_test
+
+import (
+	"testing"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyList(t *testing.T) {
+	client := new(internal.MockAPIClient)
+	client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+		assert.Equal(t, "/Keys.json", uri)
+
+		return []byte(`
+		{
+			"page": 0,
+			"page_size": 50,
+			"next_page_uri": "\/2010-04-01\/Accounts\/TwilioloFake\/Keys.json?Page=1&PageSize=50&PageToken=PASKTwilioloFake",
+			"keys": [{"sid": "SKTwilioloFake"}, {"sid": "SKTwilioloFake2"}]
+		}`), nil
+	}
+
+	service := twiliolo.KeyService{Client: client}
+	list, err := service.List()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(list.Keys))
+	assert.Equal(t, "SKTwilioloFake2", list.Keys[1].Sid)
+}
+
+func TestKeyListNextPage(t *testing.T) {
+	t.Run("OK - Follow next page URI", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/2010-04-01/Accounts/TwilioloFake/Keys.json?Page=1&PageSize=50&PageToken=PASKTwilioloFake", uri)
+			assert.Empty(t, requestOptions)
+
+			return []byte(`{"page": 1, "keys": [{"sid": "SKTwilioloFake3"}]}`), nil
+		}
+
+		service := twiliolo.KeyService{Client: client}
+		list, err := service.ListNextPage(&twiliolo.KeyList{NextPageURI: "/2010-04-01/Accounts/TwilioloFake/Keys.json?Page=1&PageSize=50&PageToken=PASKTwilioloFake"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SKTwilioloFake3", list.Keys[0].Sid)
+	})
+
+	t.Run("NOK - No next page", func(t *testing.T) {
+		service := twiliolo.KeyService{Client: new(internal.MockAPIClient)}
+
+		list, err := service.ListNextPage(&twiliolo.KeyList{})
+
+		assert.Equal(t, twiliolo.ErrNoNextPage, err)
+		assert.Nil(t, list)
+	})
+}
@@ -0,0 +1,93 @@
+package twiliolo_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyCreate(t *testing.T) {
+	client := new(internal.MockAPIClient)
+	client.PostFn = func(uri string, _ []option.RequestOption, values url.Values) ([]byte, error) {
+		assert.Equal(t, "/Keys.json", uri)
+		assert.Equal(t, "Worker", values.Get("FriendlyName"))
+
+		return []byte(`
+		{
+			"sid": "SKTwilioloFake",
+			"friendly_name": "Worker",
+			"secret": "Secret",
+			"date_created": "Mon, 16 Aug 2010 03:45:01 +0000",
+			"date_updated": "Mon, 16 Aug 2010 03:45:01 +0000"
+		}`), nil
+	}
+
+	service := twiliolo.KeyService{Client: client}
+	key, err := service.Create("Worker")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, client.PostCall)
+	assert.Equal(t, "SKTwilioloFake", key.Sid)
+	assert.Equal(t, "Secret", key.Secret)
+	assert.Equal(t, time.Date(2010, time.August, 16, 3, 45, 1, 0, time.UTC), key.DateCreated)
+}
+
+func TestKeyGet(t *testing.T) {
+	t.Run("OK - Key retrieved", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/Keys/SKTwilioloFake.json", uri)
+
+			return []byte(`{"sid": "SKTwilioloFake", "friendly_name": "Worker"}`), nil
+		}
+
+		service := twiliolo.KeyService{Client: client}
+		key, err := service.Get("SKTwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Worker", key.FriendlyName)
+		assert.Equal(t, "", key.Secret)
+	})
+
+	t.Run("NOK - Missing sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.KeyService{Client: client}
+
+		key, err := service.Get("")
+
+		assert.Equal(t, twiliolo.ErrKeyMissingData, err)
+		assert.Nil(t, key)
+	})
+}
+
+func TestKeyDelete(t *testing.T) {
+	t.Run("OK - Key deleted", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.DeleteFn = func(uri string, _ []option.RequestOption) error {
+			assert.Equal(t, "/Keys/SKTwilioloFake.json", uri)
+
+			return nil
+		}
+
+		service := twiliolo.KeyService{Client: client}
+		err := service.Delete("SKTwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, client.DeleteCall)
+	})
+
+	t.Run("NOK - Missing sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.KeyService{Client: client}
+
+		err := service.Delete("")
+
+		assert.Equal(t, twiliolo.ErrKeyMissingData, err)
+		assert.Equal(t, 0, client.DeleteCall)
+	})
+}
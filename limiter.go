@@ -0,0 +1,145 @@
+package twiliolo
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Limiter is the interface of a client-side limiter pacing the requests sent by a TwilioAPIClient.
+type Limiter interface {
+	// Acquire blocks until a request can be sent, or fails. The returned
+	// function must be called once the request is over.
+	Acquire(context.Context) (func(), error)
+	// QueueDepth returns the number of requests currently waiting in Acquire.
+	QueueDepth() int
+}
+
+// RateLimiterConfig is the configuration of a RateLimiter.
+type RateLimiterConfig struct {
+	// RequestsPerSecond is the refill rate of the token bucket, 0 disables it.
+	RequestsPerSecond float64
+	// Burst is the size of the token bucket, defaulting to 1.
+	Burst int
+	// MaxConcurrent is the maximum number of requests in flight, 0 disables it.
+	MaxConcurrent int
+	// FailFast makes Acquire return ErrRateLimited instead of waiting.
+	FailFast bool
+}
+
+// RateLimiter is a Limiter combining a token bucket and a concurrency limit.
+type RateLimiter struct {
+	config  RateLimiterConfig
+	slots   chan struct{}
+	waiting int64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+var _ Limiter = &RateLimiter{}
+
+// NewRateLimiter instanciates a new RateLimiter with a full token bucket
+func NewRateLimiter(config RateLimiterConfig) *RateLimiter {
+	if config.Burst < 1 {
+		config.Burst = 1
+	}
+
+	l := &RateLimiter{
+		config: config,
+		tokens: float64(config.Burst),
+		last:   time.Now(),
+	}
+
+	if config.MaxConcurrent > 0 {
+		l.slots = make(chan struct{}, config.MaxConcurrent)
+	}
+
+	return l
+}
+
+// Acquire waits for a free concurrency slot and a token of the bucket.
+// With FailFast it returns ErrRateLimited when none is immediately available.
+func (l *RateLimiter) Acquire(ctx context.Context) (func(), error) {
+	if l.config.FailFast {
+		return l.tryAcquire()
+	}
+
+	atomic.AddInt64(&l.waiting, 1)
+	defer atomic.AddInt64(&l.waiting, -1)
+
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	for {
+		delay := l.reserve()
+		if delay == 0 {
+			return l.release, nil
+		}
+
+		if err := wait(ctx, delay); err != nil {
+			l.release()
+			return nil, err
+		}
+	}
+}
+
+// QueueDepth returns the number of requests currently waiting in Acquire.
+func (l *RateLimiter) QueueDepth() int {
+	return int(atomic.LoadInt64(&l.waiting))
+}
+
+func (l *RateLimiter) tryAcquire() (func(), error) {
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		default:
+			return nil, ErrRateLimited
+		}
+	}
+
+	if l.reserve() != 0 {
+		l.release()
+		return nil, ErrRateLimited
+	}
+
+	return l.release, nil
+}
+
+// reserve takes a token from the bucket when available, otherwise it returns
+// the delay after which one will be.
+func (l *RateLimiter) reserve() time.Duration {
+	if l.config.RequestsPerSecond <= 0 {
+		return 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.config.RequestsPerSecond
+	if l.tokens > float64(l.config.Burst) {
+		l.tokens = float64(l.config.Burst)
+	}
+	l.last = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - l.tokens) / l.config.RequestsPerSecond * float64(time.Second))
+}
+
+func (l *RateLimiter) release() {
+	if l.slots != nil {
+		<-l.slots
+	}
+}
@@ -0,0 +1,94 @@
+package twiliolo_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+)
+
+func TestRateLimiter(t *testing.T) {
+	t.Run("OK - Token bucket paces requests", func(t *testing.T) {
+		limiter := twiliolo.NewRateLimiter(twiliolo.RateLimiterConfig{RequestsPerSecond: 100, Burst: 1})
+
+		start := time.Now()
+		for i := 0; i < 3; i++ {
+			release, err := limiter.Acquire(context.Background())
+			assert.NoError(t, err)
+			release()
+		}
+
+		assert.True(t, time.Since(start) >= 15*time.Millisecond)
+	})
+
+	t.Run("NOK - Fail fast on empty bucket", func(t *testing.T) {
+		limiter := twiliolo.NewRateLimiter(twiliolo.RateLimiterConfig{RequestsPerSecond: 1, Burst: 1, FailFast: true})
+
+		release, err := limiter.Acquire(context.Background())
+		assert.NoError(t, err)
+		release()
+
+		_, err = limiter.Acquire(context.Background())
+		assert.Equal(t, twiliolo.ErrRateLimited, err)
+	})
+
+	t.Run("OK - Concurrency limit and queue depth", func(t *testing.T) {
+		limiter := twiliolo.NewRateLimiter(twiliolo.RateLimiterConfig{MaxConcurrent: 1})
+
+		release, err := limiter.Acquire(context.Background())
+		assert.NoError(t, err)
+
+		acquired := make(chan struct{})
+		go func() {
+			secondRelease, err := limiter.Acquire(context.Background())
+			assert.NoError(t, err)
+			secondRelease()
+			close(acquired)
+		}()
+
+		for limiter.QueueDepth() != 1 {
+			time.Sleep(time.Millisecond)
+		}
+
+		release()
+		<-acquired
+		assert.Equal(t, 0, limiter.QueueDepth())
+	})
+
+	t.Run("NOK - Context canceled while waiting", func(t *testing.T) {
+		limiter := twiliolo.NewRateLimiter(twiliolo.RateLimiterConfig{MaxConcurrent: 1})
+		_, err := limiter.Acquire(context.Background())
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+
+		_, err = limiter.Acquire(ctx)
+		assert.Equal(t, context.DeadlineExceeded, err)
+	})
+
+	t.Run("NOK - Client request refused", func(t *testing.T) {
+		httpMock := internal.HTTPMockClient{}
+
+		client := twiliolo.NewTwilioAPIClient(ACCOUNT_SID, AUTH_TOKEN, &httpMock)
+		client.RetryPolicy = testRetryPolicy()
+		client.Limiter = twiliolo.NewRateLimiter(twiliolo.RateLimiterConfig{RequestsPerSecond: 1, FailFast: true})
+
+		httpMock.DoFn = func(req *http.Request) (*http.Response, error) {
+			return newStatusResponse(200, "Success", http.Header{}), nil
+		}
+
+		_, err := client.Get("/TestGet", make([]option.RequestOption, 0))
+		assert.NoError(t, err)
+
+		_, err = client.Get("/TestGet", make([]option.RequestOption, 0))
+		assert.Equal(t, twiliolo.ErrRateLimited, err)
+		assert.Equal(t, 1, httpMock.DoCall)
+	})
+}
@@ -0,0 +1,249 @@
+package twiliolo
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// MessageServiceInterface is the interface of a MessageService
+type MessageServiceInterface interface {
+	Create(*MessageParams, ...option.RequestOption) (*Message, error)
+	Get(string, ...option.RequestOption) (*Message, error)
+	Redact(string, ...option.RequestOption) (*Message, error)
+	Delete(string, ...option.RequestOption) error
+	List(...option.RequestOption) (*MessageList, error)
+	ListNextPage(*MessageList) (*MessageList, error)
+	Iter(...option.RequestOption) *Iterator[*Message]
+	CreateContext(context.Context, *MessageParams, ...option.RequestOption) (*Message, error)
+	GetContext(context.Context, string, ...option.RequestOption) (*Message, error)
+	RedactContext(context.Context, string, ...option.RequestOption) (*Message, error)
+	DeleteContext(context.Context, string, ...option.RequestOption) error
+	ListContext(context.Context, ...option.RequestOption) (*MessageList, error)
+	ListNextPageContext(context.Context, *MessageList) (*MessageList, error)
+	IterContext(context.Context, ...option.RequestOption) *Iterator[*Message]
+}
+
+// MessageService handles communication with the Message related methods.
+type MessageService service
+
+// MessageStatus is the delivery status of a Message.
+type MessageStatus string
+
+// Possible values of a MessageStatus.
+const (
+	MessageStatusAccepted           MessageStatus = "accepted"
+	MessageStatusScheduled          MessageStatus = "scheduled"
+	MessageStatusCanceled           MessageStatus = "canceled"
+	MessageStatusQueued             MessageStatus = "queued"
+	MessageStatusSending            MessageStatus = "sending"
+	MessageStatusSent               MessageStatus = "sent"
+	MessageStatusFailed             MessageStatus = "failed"
+	MessageStatusDelivered          MessageStatus = "delivered"
+	MessageStatusUndelivered        MessageStatus = "undelivered"
+	MessageStatusReceiving          MessageStatus = "receiving"
+	MessageStatusReceived           MessageStatus = "received"
+	MessageStatusRead               MessageStatus = "read"
+	MessageStatusPartiallyDelivered MessageStatus = "partially_delivered"
+)
+
+// Message represents a Twilio SMS or MMS.
+type Message struct {
+	Sid                 string            `json:"sid"`
+	AccountSid          string            `json:"account_sid"`
+	MessagingServiceSid string            `json:"messaging_service_sid"`
+	From                string            `json:"from"`
+	To                  string            `json:"to"`
+	Body                string            `json:"body"`
+	Status              MessageStatus     `json:"status"`
+	Direction           string            `json:"direction"`
+	NumSegments         string            `json:"num_segments"`
+	NumMedia            string            `json:"num_media"`
+	Price               string            `json:"price"`
+	PriceUnit           string            `json:"price_unit"`
+	ErrorCode           int               `json:"error_code"`
+	ErrorMessage        string            `json:"error_message"`
+	DateCreated         time.Time         `json:"date_created"`
+	DateUpdated         time.Time         `json:"date_updated"`
+	DateSent            time.Time         `json:"date_sent"`
+	APIVersion          string            `json:"api_version"`
+	URI                 string            `json:"uri"`
+	SubresourceURIs     map[string]string `json:"subresource_uris"`
+}
+
+// UnmarshalJSON decodes a Message, parsing its RFC 2822 dates.
+func (m *Message) UnmarshalJSON(data []byte) error {
+	type message Message
+
+	raw := struct {
+		*message
+		DateCreated string `json:"date_created"`
+		DateUpdated string `json:"date_updated"`
+		DateSent    string `json:"date_sent"`
+	}{message: (*message)(m)}
+
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	m.DateCreated, err = parseDate(raw.DateCreated)
+	if err != nil {
+		return err
+	}
+
+	m.DateUpdated, err = parseDate(raw.DateUpdated)
+	if err != nil {
+		return err
+	}
+
+	m.DateSent, err = parseDate(raw.DateSent)
+
+	return err
+}
+
+// MarshalJSON encodes a Message, formatting its dates in RFC 2822 like Twilio.
+func (m Message) MarshalJSON() ([]byte, error) {
+	type message Message
+
+	return json.Marshal(struct {
+		message
+		DateCreated string `json:"date_created"`
+		DateUpdated string `json:"date_updated"`
+		DateSent    string `json:"date_sent"`
+	}{
+		message:     message(m),
+		DateCreated: formatDate(m.DateCreated),
+		DateUpdated: formatDate(m.DateUpdated),
+		DateSent:    formatDate(m.DateSent),
+	})
+}
+
+// MessageParams contains the parameters used to send a new Message.
+// To is required along with From or MessagingServiceSid, and Body or MediaURL.
+type MessageParams struct {
+	To                  string
+	From                string
+	MessagingServiceSid string
+	Body                string
+	MediaURL            []string
+	StatusCallback      string
+	// ValidityPeriod is the number of seconds the message can remain queued, ignored when 0.
+	ValidityPeriod int
+}
+
+// Create sends a new Message.
+// Doc: https://www.twilio.com/docs/sms/api/message-resource#create-a-message-resource
+func (s *MessageService) Create(params *MessageParams, requestOptions ...option.RequestOption) (*Message, error) {
+	return s.CreateContext(context.Background(), params, requestOptions...)
+}
+
+// CreateContext performs the same call as Create, bound to the given context.
+func (s *MessageService) CreateContext(ctx context.Context, params *MessageParams, requestOptions ...option.RequestOption) (*Message, error) {
+	if params == nil || params.To == "" || (params.From == "" && params.MessagingServiceSid == "") || (params.Body == "" && len(params.MediaURL) == 0) {
+		return nil, ErrMessageMissingData
+	}
+
+	values := url.Values{}
+	values.Set("To", params.To)
+	if params.From != "" {
+		values.Set("From", params.From)
+	}
+	if params.MessagingServiceSid != "" {
+		values.Set("MessagingServiceSid", params.MessagingServiceSid)
+	}
+	if params.Body != "" {
+		values.Set("Body", params.Body)
+	}
+	for _, mediaURL := range params.MediaURL {
+		values.Add("MediaUrl", mediaURL)
+	}
+	if params.StatusCallback != "" {
+		values.Set("StatusCallback", params.StatusCallback)
+	}
+	if params.ValidityPeriod != 0 {
+		values.Set("ValidityPeriod", strconv.Itoa(params.ValidityPeriod))
+	}
+
+	body, err := s.Client.PostContext(ctx, "/Messages.json", requestOptions, values)
+	if err != nil {
+		return nil, err
+	}
+
+	var message Message
+
+	err = json.Unmarshal(body, &message)
+	if err != nil {
+		return nil, err
+	}
+
+	return &message, nil
+}
+
+// Get performs a call to the twilio API to retrieve a Message with its Sid.
+// Doc: https://www.twilio.com/docs/sms/api/message-resource#fetch-a-message-resource
+func (s *MessageService) Get(sid string, requestOptions ...option.RequestOption) (*Message, error) {
+	return s.GetContext(context.Background(), sid, requestOptions...)
+}
+
+// GetContext performs the same call as Get, bound to the given context.
+func (s *MessageService) GetContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*Message, error) {
+	if sid == "" {
+		return nil, ErrMessageMissingData
+	}
+
+	res, err := s.Client.GetContext(ctx, "/Messages/"+sid+".json", requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	message := new(Message)
+	err = json.Unmarshal(res, message)
+
+	return message, err
+}
+
+// Redact removes the Body of a sent Message by updating it with an empty one.
+// Doc: https://www.twilio.com/docs/sms/api/message-resource#update-a-message-resource
+func (s *MessageService) Redact(sid string, requestOptions ...option.RequestOption) (*Message, error) {
+	return s.RedactContext(context.Background(), sid, requestOptions...)
+}
+
+// RedactContext performs the same call as Redact, bound to the given context.
+func (s *MessageService) RedactContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*Message, error) {
+	if sid == "" {
+		return nil, ErrMessageMissingData
+	}
+
+	updates := url.Values{}
+	updates.Set("Body", "")
+
+	res, err := s.Client.PostContext(ctx, "/Messages/"+sid+".json", requestOptions, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	message := new(Message)
+	err = json.Unmarshal(res, message)
+
+	return message, err
+}
+
+// Delete removes a Message from the account logs.
+// Doc: https://www.twilio.com/docs/sms/api/message-resource#delete-a-message-resource
+func (s *MessageService) Delete(sid string, requestOptions ...option.RequestOption) error {
+	return s.DeleteContext(context.Background(), sid, requestOptions...)
+}
+
+// DeleteContext performs the same call as Delete, bound to the given context.
+func (s *MessageService) DeleteContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) error {
+	if sid == "" {
+		return ErrMessageMissingData
+	}
+
+	return s.Client.DeleteContext(ctx, "/Messages/"+sid+".json", requestOptions)
+}
@@ -0,0 +1,77 @@
+package twiliolo
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// MessageList represents the response of the Twilio API when calling /Messages.json
+type MessageList struct {
+	Page            int        `json:"page"`
+	PageSize        int        `json:"page_size"`
+	URI             string     `json:"uri"`
+	FirstPageURI    string     `json:"first_page_uri"`
+	NextPageURI     string     `json:"next_page_uri"`
+	PreviousPageURI string     `json:"previous_page_uri"`
+	Messages        []*Message `json:"messages"`
+}
+
+// List retrieves the first page of the Messages, filtered with the To, From
+// and DateSent options.
+// Doc: https://www.twilio.com/docs/sms/api/message-resource#read-multiple-message-resources
+func (s *MessageService) List(requestOptions ...option.RequestOption) (*MessageList, error) {
+	return s.ListContext(context.Background(), requestOptions...)
+}
+
+// ListContext performs the same call as List, bound to the given context.
+func (s *MessageService) ListContext(ctx context.Context, requestOptions ...option.RequestOption) (*MessageList, error) {
+	body, err := s.Client.GetContext(ctx, "/Messages.json", requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	messageList := new(MessageList)
+	err = json.Unmarshal(body, messageList)
+
+	return messageList, err
+}
+
+// ListNextPage retrieves the next page of a given MessageList by following its NextPageURI.
+// If an empty NextPageURI is present in the struct it'll return an error
+func (s *MessageService) ListNextPage(previousList *MessageList) (*MessageList, error) {
+	return s.ListNextPageContext(context.Background(), previousList)
+}
+
+// ListNextPageContext performs the same call as ListNextPage, bound to the given context.
+func (s *MessageService) ListNextPageContext(ctx context.Context, previousList *MessageList) (*MessageList, error) {
+	if previousList == nil || previousList.NextPageURI == "" {
+		return nil, ErrNoNextPage
+	}
+
+	body, err := s.Client.GetContext(ctx, previousList.NextPageURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	messageList := new(MessageList)
+	err = json.Unmarshal(body, messageList)
+
+	return messageList, err
+}
+
+// Iter returns an Iterator over all the Messages matching the given options.
+func (s *MessageService) Iter(requestOptions ...option.RequestOption) *Iterator[*Message] {
+	return s.IterContext(context.Background(), requestOptions...)
+}
+
+// IterContext performs the same calls as Iter, bound to the given context.
+func (s *MessageService) IterContext(ctx context.Context, requestOptions ...option.RequestOption) *Iterator[*Message] {
+	return newIterator(ctx, s.Client, "/Messages.json", requestOptions, func(body []byte) ([]*Message, string, error) {
+		list := new(MessageList)
+		err := json.Unmarshal(body, list)
+
+		return list.Messages, list.NextPageURI, err
+	})
+}
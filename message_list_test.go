@@ -0,0 +1,76 @@
+package twiliolo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageList(t *testing.T) {
+	dateSent := time.Date(2017, time.March, 12, 0, 0, 0, 0, time.UTC)
+
+	client := new(internal.MockAPIClient)
+	client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+		assert.Equal(t, "/Messages.json", uri)
+		assert.Equal(t, []option.RequestOption{option.To("+33687654321"), option.DateSentAfter(dateSent)}, requestOptions)
+
+		key, value := requestOptions[1].GetValue()
+		assert.Equal(t, "DateSent>", key)
+		assert.Equal(t, "2017-03-12", value)
+
+		return []byte(`
+		{
+			"page": 0,
+			"page_size": 50,
+			"uri": "\/2010-04-01\/Accounts\/TwilioloFake\/Messages.json",
+			"first_page_uri": "\/2010-04-01\/Accounts\/TwilioloFake\/Messages.json?Page=0&PageSize=50",
+			"previous_page_uri": null,
+			"next_page_uri": "\/2010-04-01\/Accounts\/TwilioloFake\/Messages.json?Page=1&PageSize=50&PageToken=PASMTwilioloFake",
+			"messages": [{"sid": "SMTwilioloFake"}, {"sid": "SMTwilioloFake2"}]
+		}`), nil
+	}
+
+	service := twiliolo.MessageService{Client: client}
+	list, err := service.List(option.To("+33687654321"), option.DateSentAfter(dateSent))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 50, list.PageSize)
+	assert.Equal(t, 2, len(list.Messages))
+	assert.Equal(t, "SMTwilioloFake2", list.Messages[1].Sid)
+}
+
+func TestMessageListNextPage(t *testing.T) {
+	t.Run("OK - Follow next page URI", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/2010-04-01/Accounts/TwilioloFake/Messages.json?Page=1&PageSize=50&PageToken=PASMTwilioloFake", uri)
+			assert.Empty(t, requestOptions)
+
+			return []byte(`{"page": 1, "page_size": 50, "next_page_uri": null, "messages": [{"sid": "SMTwilioloFake3"}]}`), nil
+		}
+
+		service := twiliolo.MessageService{Client: client}
+		list, err := service.ListNextPage(&twiliolo.MessageList{
+			NextPageURI: "/2010-04-01/Accounts/TwilioloFake/Messages.json?Page=1&PageSize=50&PageToken=PASMTwilioloFake",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, list.Page)
+		assert.Equal(t, "", list.NextPageURI)
+		assert.Equal(t, "SMTwilioloFake3", list.Messages[0].Sid)
+	})
+
+	t.Run("NOK - No next page", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.MessageService{Client: client}
+
+		list, err := service.ListNextPage(&twiliolo.MessageList{})
+
+		assert.Equal(t, twiliolo.ErrNoNextPage, err)
+		assert.Nil(t, list)
+	})
+}
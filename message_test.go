@@ -0,0 +1,194 @@
+package twiliolo_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+const testMessageResponse = `
+{
+	"sid": "SMTwilioloFake",
+	"account_sid": "TwilioloFake",
+	"messaging_service_sid": null,
+	"from": "+33612345678",
+	"to": "+33687654321",
+	"body": "Hello from Twiliolo",
+	"status": "queued",
+	"direction": "outbound-api",
+	"num_segments": "1",
+	"num_media": "1",
+	"price": null,
+	"price_unit": "USD",
+	"error_code": null,
+	"error_message": null,
+	"date_created": "Mon, 16 Aug 2010 03:45:01 +0000",
+	"date_updated": "Mon, 16 Aug 2010 03:45:01 +0000",
+	"date_sent": null,
+	"api_version": "2010-04-01",
+	"uri": "\/2010-04-01\/Accounts\/TwilioloFake\/Messages\/SMTwilioloFake.json",
+	"subresource_uris": {
+		"media": "\/2010-04-01\/Accounts\/TwilioloFake\/Messages\/SMTwilioloFake\/Media.json"
+	}
+}`
+
+func TestMessageCreate(t *testing.T) {
+	t.Run("OK - Message sent", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.PostFn = func(uri string, _ []option.RequestOption, values url.Values) ([]byte, error) {
+			assert.Equal(t, "/Messages.json", uri)
+			assert.Equal(t, "+33687654321", values.Get("To"))
+			assert.Equal(t, "+33612345678", values.Get("From"))
+			assert.Equal(t, "Hello from Twiliolo", values.Get("Body"))
+			assert.Equal(t, []string{"http://media.com/1.png", "http://media.com/2.png"}, values["MediaUrl"])
+			assert.Equal(t, "http://status.com", values.Get("StatusCallback"))
+			assert.Equal(t, "600", values.Get("ValidityPeriod"))
+			assert.Equal(t, "", values.Get("MessagingServiceSid"))
+
+			return []byte(testMessageResponse), nil
+		}
+
+		service := twiliolo.MessageService{Client: client}
+		message, err := service.Create(&twiliolo.MessageParams{
+			To:             "+33687654321",
+			From:           "+33612345678",
+			Body:           "Hello from Twiliolo",
+			MediaURL:       []string{"http://media.com/1.png", "http://media.com/2.png"},
+			StatusCallback: "http://status.com",
+			ValidityPeriod: 600,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, client.PostCall)
+		assert.Equal(t, "SMTwilioloFake", message.Sid)
+		assert.Equal(t, twiliolo.MessageStatusQueued, message.Status)
+		assert.Equal(t, "/2010-04-01/Accounts/TwilioloFake/Messages/SMTwilioloFake/Media.json", message.SubresourceURIs["media"])
+	})
+
+	t.Run("NOK - Missing sender", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.MessageService{Client: client}
+
+		message, err := service.Create(&twiliolo.MessageParams{To: "+33687654321", Body: "Hello"})
+
+		assert.Equal(t, twiliolo.ErrMessageMissingData, err)
+		assert.Nil(t, message)
+		assert.Equal(t, 0, client.PostCall)
+	})
+}
+
+func TestMessageGet(t *testing.T) {
+	t.Run("OK - Get Message", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/Messages/SMTwilioloFake.json", uri)
+
+			return []byte(testMessageResponse), nil
+		}
+
+		service := twiliolo.MessageService{Client: client}
+		message, err := service.Get("SMTwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SMTwilioloFake", message.Sid)
+		assert.Equal(t, "+33687654321", message.To)
+		assert.Equal(t, "Hello from Twiliolo", message.Body)
+		assert.Equal(t, time.Date(2010, time.August, 16, 3, 45, 1, 0, time.UTC), message.DateCreated)
+		assert.True(t, message.DateSent.IsZero())
+	})
+
+	t.Run("NOK - Missing sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.MessageService{Client: client}
+
+		message, err := service.Get("")
+
+		assert.Equal(t, twiliolo.ErrMessageMissingData, err)
+		assert.Nil(t, message)
+		assert.Equal(t, 0, client.GetCall)
+	})
+}
+
+func TestMessageMarshalJSON(t *testing.T) {
+	message := twiliolo.Message{
+		Sid:         "SMTwilioloFake",
+		DateCreated: time.Date(2010, time.August, 16, 3, 45, 1, 0, time.UTC),
+		DateSent:    time.Date(2010, time.August, 16, 3, 45, 2, 0, time.UTC),
+	}
+
+	data, err := json.Marshal(message)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"date_created":"Mon, 16 Aug 2010 03:45:01 +0000"`)
+	assert.Contains(t, string(data), `"date_updated":""`)
+
+	var decoded twiliolo.Message
+
+	err = json.Unmarshal(data, &decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, message, decoded)
+}
+
+func TestMessageRedact(t *testing.T) {
+	t.Run("OK - Body redacted", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.PostFn = func(uri string, _ []option.RequestOption, values url.Values) ([]byte, error) {
+			assert.Equal(t, "/Messages/SMTwilioloFake.json", uri)
+			assert.Equal(t, url.Values{"Body": []string{""}}, values)
+
+			return []byte(`{"sid": "SMTwilioloFake", "body": ""}`), nil
+		}
+
+		service := twiliolo.MessageService{Client: client}
+		message, err := service.Redact("SMTwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, client.PostCall)
+		assert.Equal(t, "SMTwilioloFake", message.Sid)
+		assert.Equal(t, "", message.Body)
+	})
+
+	t.Run("NOK - Missing sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.MessageService{Client: client}
+
+		message, err := service.Redact("")
+
+		assert.Equal(t, twiliolo.ErrMessageMissingData, err)
+		assert.Nil(t, message)
+		assert.Equal(t, 0, client.PostCall)
+	})
+}
+
+func TestMessageDelete(t *testing.T) {
+	t.Run("NOK - Error in API", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.DeleteFn = func(uri string, _ []option.RequestOption) error {
+			assert.Equal(t, "/Messages/SMTwilioloFake.json", uri)
+
+			return errors.New("Error in API")
+		}
+
+		service := twiliolo.MessageService{Client: client}
+		err := service.Delete("SMTwilioloFake")
+
+		assert.EqualError(t, err, "Error in API")
+		assert.Equal(t, 1, client.DeleteCall)
+	})
+
+	t.Run("NOK - Missing sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.MessageService{Client: client}
+
+		err := service.Delete("")
+
+		assert.Equal(t, twiliolo.ErrMessageMissingData, err)
+		assert.Equal(t, 0, client.DeleteCall)
+	})
+}
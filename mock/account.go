@@ -0,0 +1,156 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/option"
+)
+
+// AccountService is the mock of a AccountService
+type AccountService struct {
+	CreateFn                func(string, []option.RequestOption) (*twiliolo.Account, error)
+	CreateCall              int
+	GetFn                   func(string, []option.RequestOption) (*twiliolo.Account, error)
+	GetCall                 int
+	SuspendFn               func(string, []option.RequestOption) (*twiliolo.Account, error)
+	SuspendCall             int
+	ReactivateFn            func(string, []option.RequestOption) (*twiliolo.Account, error)
+	ReactivateCall          int
+	CloseFn                 func(string, []option.RequestOption) (*twiliolo.Account, error)
+	CloseCall               int
+	ListFn                  func([]option.RequestOption) (*twiliolo.AccountList, error)
+	ListCall                int
+	ListNextPageFn          func(*twiliolo.AccountList) (*twiliolo.AccountList, error)
+	ListNextPageCall        int
+	IterFn                  func([]option.RequestOption) *twiliolo.Iterator[*twiliolo.Account]
+	IterCall                int
+	CreateContextFn         func(context.Context, string, []option.RequestOption) (*twiliolo.Account, error)
+	CreateContextCall       int
+	GetContextFn            func(context.Context, string, []option.RequestOption) (*twiliolo.Account, error)
+	GetContextCall          int
+	SuspendContextFn        func(context.Context, string, []option.RequestOption) (*twiliolo.Account, error)
+	SuspendContextCall      int
+	ReactivateContextFn     func(context.Context, string, []option.RequestOption) (*twiliolo.Account, error)
+	ReactivateContextCall   int
+	CloseContextFn          func(context.Context, string, []option.RequestOption) (*twiliolo.Account, error)
+	CloseContextCall        int
+	ListContextFn           func(context.Context, []option.RequestOption) (*twiliolo.AccountList, error)
+	ListContextCall         int
+	ListNextPageContextFn   func(context.Context, *twiliolo.AccountList) (*twiliolo.AccountList, error)
+	ListNextPageContextCall int
+	IterContextFn           func(context.Context, []option.RequestOption) *twiliolo.Iterator[*twiliolo.Account]
+	IterContextCall         int
+}
+
+// Create mocked function.
+func (s *AccountService) Create(friendlyName string, requestOptions ...option.RequestOption) (*twiliolo.Account, error) {
+	s.CreateCall++
+
+	return s.CreateFn(friendlyName, requestOptions)
+}
+
+// Get mocked function.
+func (s *AccountService) Get(sid string, requestOptions ...option.RequestOption) (*twiliolo.Account, error) {
+	s.GetCall++
+
+	return s.GetFn(sid, requestOptions)
+}
+
+// Suspend mocked function.
+func (s *AccountService) Suspend(sid string, requestOptions ...option.RequestOption) (*twiliolo.Account, error) {
+	s.SuspendCall++
+
+	return s.SuspendFn(sid, requestOptions)
+}
+
+// Reactivate mocked function.
+func (s *AccountService) Reactivate(sid string, requestOptions ...option.RequestOption) (*twiliolo.Account, error) {
+	s.ReactivateCall++
+
+	return s.ReactivateFn(sid, requestOptions)
+}
+
+// Close mocked function.
+func (s *AccountService) Close(sid string, requestOptions ...option.RequestOption) (*twiliolo.Account, error) {
+	s.CloseCall++
+
+	return s.CloseFn(sid, requestOptions)
+}
+
+// List mocked function.
+func (s *AccountService) List(requestOptions ...option.RequestOption) (*twiliolo.AccountList, error) {
+	s.ListCall++
+
+	return s.ListFn(requestOptions)
+}
+
+// ListNextPage mocked function.
+func (s *AccountService) ListNextPage(previousList *twiliolo.AccountList) (*twiliolo.AccountList, error) {
+	s.ListNextPageCall++
+
+	return s.ListNextPageFn(previousList)
+}
+
+// Iter mocked function.
+func (s *AccountService) Iter(requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Account] {
+	s.IterCall++
+
+	return s.IterFn(requestOptions)
+}
+
+// CreateContext mocked function.
+func (s *AccountService) CreateContext(ctx context.Context, friendlyName string, requestOptions ...option.RequestOption) (*twiliolo.Account, error) {
+	s.CreateContextCall++
+
+	return s.CreateContextFn(ctx, friendlyName, requestOptions)
+}
+
+// GetContext mocked function.
+func (s *AccountService) GetContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*twiliolo.Account, error) {
+	s.GetContextCall++
+
+	return s.GetContextFn(ctx, sid, requestOptions)
+}
+
+// SuspendContext mocked function.
+func (s *AccountService) SuspendContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*twiliolo.Account, error) {
+	s.SuspendContextCall++
+
+	return s.SuspendContextFn(ctx, sid, requestOptions)
+}
+
+// ReactivateContext mocked function.
+func (s *AccountService) ReactivateContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*twiliolo.Account, error) {
+	s.ReactivateContextCall++
+
+	return s.ReactivateContextFn(ctx, sid, requestOptions)
+}
+
+// CloseContext mocked function.
+func (s *AccountService) CloseContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*twiliolo.Account, error) {
+	s.CloseContextCall++
+
+	return s.CloseContextFn(ctx, sid, requestOptions)
+}
+
+// ListContext mocked function.
+func (s *AccountService) ListContext(ctx context.Context, requestOptions ...option.RequestOption) (*twiliolo.AccountList, error) {
+	s.ListContextCall++
+
+	return s.ListContextFn(ctx, requestOptions)
+}
+
+// ListNextPageContext mocked function.
+func (s *AccountService) ListNextPageContext(ctx context.Context, previousList *twiliolo.AccountList) (*twiliolo.AccountList, error) {
+	s.ListNextPageContextCall++
+
+	return s.ListNextPageContextFn(ctx, previousList)
+}
+
+// IterContext mocked function.
+func (s *AccountService) IterContext(ctx context.Context, requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Account] {
+	s.IterContextCall++
+
+	return s.IterContextFn(ctx, requestOptions)
+}
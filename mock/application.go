@@ -0,0 +1,192 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/option"
+)
+
+// ApplicationService is the mock of a ApplicationService
+type ApplicationService struct {
+	CreateFn                func(*twiliolo.ApplicationParams, []option.RequestOption) (*twiliolo.Application, error)
+	CreateCall              int
+	GetFn                   func(string, []option.RequestOption) (*twiliolo.Application, error)
+	GetCall                 int
+	UpdateFn                func(string, *twiliolo.ApplicationUpdate, []option.RequestOption) (*twiliolo.Application, error)
+	UpdateCall              int
+	DeleteFn                func(string, []option.RequestOption) error
+	DeleteCall              int
+	ListFn                  func([]option.RequestOption) (*twiliolo.ApplicationList, error)
+	ListCall                int
+	ListNextPageFn          func(*twiliolo.ApplicationList) (*twiliolo.ApplicationList, error)
+	ListNextPageCall        int
+	IterFn                  func([]option.RequestOption) *twiliolo.Iterator[*twiliolo.Application]
+	IterCall                int
+	AttachVoiceFn           func(string, string, []option.RequestOption) (*twiliolo.IncomingPhoneNumber, error)
+	AttachVoiceCall         int
+	AttachSMSFn             func(string, string, []option.RequestOption) (*twiliolo.IncomingPhoneNumber, error)
+	AttachSMSCall           int
+	FindNumbersFn           func(string, []option.RequestOption) ([]*twiliolo.IncomingPhoneNumber, error)
+	FindNumbersCall         int
+	CreateContextFn         func(context.Context, *twiliolo.ApplicationParams, []option.RequestOption) (*twiliolo.Application, error)
+	CreateContextCall       int
+	GetContextFn            func(context.Context, string, []option.RequestOption) (*twiliolo.Application, error)
+	GetContextCall          int
+	UpdateContextFn         func(context.Context, string, *twiliolo.ApplicationUpdate, []option.RequestOption) (*twiliolo.Application, error)
+	UpdateContextCall       int
+	DeleteContextFn         func(context.Context, string, []option.RequestOption) error
+	DeleteContextCall       int
+	ListContextFn           func(context.Context, []option.RequestOption) (*twiliolo.ApplicationList, error)
+	ListContextCall         int
+	ListNextPageContextFn   func(context.Context, *twiliolo.ApplicationList) (*twiliolo.ApplicationList, error)
+	ListNextPageContextCall int
+	IterContextFn           func(context.Context, []option.RequestOption) *twiliolo.Iterator[*twiliolo.Application]
+	IterContextCall         int
+	AttachVoiceContextFn    func(context.Context, string, string, []option.RequestOption) (*twiliolo.IncomingPhoneNumber, error)
+	AttachVoiceContextCall  int
+	AttachSMSContextFn      func(context.Context, string, string, []option.RequestOption) (*twiliolo.IncomingPhoneNumber, error)
+	AttachSMSContextCall    int
+	FindNumbersContextFn    func(context.Context, string, []option.RequestOption) ([]*twiliolo.IncomingPhoneNumber, error)
+	FindNumbersContextCall  int
+}
+
+// Create mocked function.
+func (s *ApplicationService) Create(params *twiliolo.ApplicationParams, requestOptions ...option.RequestOption) (*twiliolo.Application, error) {
+	s.CreateCall++
+
+	return s.CreateFn(params, requestOptions)
+}
+
+// Get mocked function.
+func (s *ApplicationService) Get(sid string, requestOptions ...option.RequestOption) (*twiliolo.Application, error) {
+	s.GetCall++
+
+	return s.GetFn(sid, requestOptions)
+}
+
+// Update mocked function.
+func (s *ApplicationService) Update(sid string, update *twiliolo.ApplicationUpdate, requestOptions ...option.RequestOption) (*twiliolo.Application, error) {
+	s.UpdateCall++
+
+	return s.UpdateFn(sid, update, requestOptions)
+}
+
+// Delete mocked function.
+func (s *ApplicationService) Delete(sid string, requestOptions ...option.RequestOption) error {
+	s.DeleteCall++
+
+	return s.DeleteFn(sid, requestOptions)
+}
+
+// List mocked function.
+func (s *ApplicationService) List(requestOptions ...option.RequestOption) (*twiliolo.ApplicationList, error) {
+	s.ListCall++
+
+	return s.ListFn(requestOptions)
+}
+
+// ListNextPage mocked function.
+func (s *ApplicationService) ListNextPage(previousList *twiliolo.ApplicationList) (*twiliolo.ApplicationList, error) {
+	s.ListNextPageCall++
+
+	return s.ListNextPageFn(previousList)
+}
+
+// Iter mocked function.
+func (s *ApplicationService) Iter(requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Application] {
+	s.IterCall++
+
+	return s.IterFn(requestOptions)
+}
+
+// AttachVoice mocked function.
+func (s *ApplicationService) AttachVoice(sid string, incomingPhoneNumberSid string, requestOptions ...option.RequestOption) (*twiliolo.IncomingPhoneNumber, error) {
+	s.AttachVoiceCall++
+
+	return s.AttachVoiceFn(sid, incomingPhoneNumberSid, requestOptions)
+}
+
+// AttachSMS mocked function.
+func (s *ApplicationService) AttachSMS(sid string, incomingPhoneNumberSid string, requestOptions ...option.RequestOption) (*twiliolo.IncomingPhoneNumber, error) {
+	s.AttachSMSCall++
+
+	return s.AttachSMSFn(sid, incomingPhoneNumberSid, requestOptions)
+}
+
+// FindNumbers mocked function.
+func (s *ApplicationService) FindNumbers(sid string, requestOptions ...option.RequestOption) ([]*twiliolo.IncomingPhoneNumber, error) {
+	s.FindNumbersCall++
+
+	return s.FindNumbersFn(sid, requestOptions)
+}
+
+// CreateContext mocked function.
+func (s *ApplicationService) CreateContext(ctx context.Context, params *twiliolo.ApplicationParams, requestOptions ...option.RequestOption) (*twiliolo.Application, error) {
+	s.CreateContextCall++
+
+	return s.CreateContextFn(ctx, params, requestOptions)
+}
+
+// GetContext mocked function.
+func (s *ApplicationService) GetContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*twiliolo.Application, error) {
+	s.GetContextCall++
+
+	return s.GetContextFn(ctx, sid, requestOptions)
+}
+
+// UpdateContext mocked function.
+func (s *ApplicationService) UpdateContext(ctx context.Context, sid string, update *twiliolo.ApplicationUpdate, requestOptions ...option.RequestOption) (*twiliolo.Application, error) {
+	s.UpdateContextCall++
+
+	return s.UpdateContextFn(ctx, sid, update, requestOptions)
+}
+
+// DeleteContext mocked function.
+func (s *ApplicationService) DeleteContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) error {
+	s.DeleteContextCall++
+
+	return s.DeleteContextFn(ctx, sid, requestOptions)
+}
+
+// ListContext mocked function.
+func (s *ApplicationService) ListContext(ctx context.Context, requestOptions ...option.RequestOption) (*twiliolo.ApplicationList, error) {
+	s.ListContextCall++
+
+	return s.ListContextFn(ctx, requestOptions)
+}
+
+// ListNextPageContext mocked function.
+func (s *ApplicationService) ListNextPageContext(ctx context.Context, previousList *twiliolo.ApplicationList) (*twiliolo.ApplicationList, error) {
+	s.ListNextPageContextCall++
+
+	return s.ListNextPageContextFn(ctx, previousList)
+}
+
+// IterContext mocked function.
+func (s *ApplicationService) IterContext(ctx context.Context, requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Application] {
+	s.IterContextCall++
+
+	return s.IterContextFn(ctx, requestOptions)
+}
+
+// AttachVoiceContext mocked function.
+func (s *ApplicationService) AttachVoiceContext(ctx context.Context, sid string, incomingPhoneNumberSid string, requestOptions ...option.RequestOption) (*twiliolo.IncomingPhoneNumber, error) {
+	s.AttachVoiceContextCall++
+
+	return s.AttachVoiceContextFn(ctx, sid, incomingPhoneNumberSid, requestOptions)
+}
+
+// AttachSMSContext mocked function.
+func (s *ApplicationService) AttachSMSContext(ctx context.Context, sid string, incomingPhoneNumberSid string, requestOptions ...option.RequestOption) (*twiliolo.IncomingPhoneNumber, error) {
+	s.AttachSMSContextCall++
+
+	return s.AttachSMSContextFn(ctx, sid, incomingPhoneNumberSid, requestOptions)
+}
+
+// FindNumbersContext mocked function.
+func (s *ApplicationService) FindNumbersContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) ([]*twiliolo.IncomingPhoneNumber, error) {
+	s.FindNumbersContextCall++
+
+	return s.FindNumbersContextFn(ctx, sid, requestOptions)
+}
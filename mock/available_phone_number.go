@@ -1,16 +1,58 @@
 package mock
 
 import (
+	"context"
+
 	"github.com/genesor/twiliolo"
 	"github.com/genesor/twiliolo/option"
 )
 
 // AvailablePhoneNumberService is the mock of a AvailablePhoneNumberService
 type AvailablePhoneNumberService struct {
-	LocalFn   func(string, []option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error)
-	LocalCall int
-	BuyFn     func(*twiliolo.AvailablePhoneNumber, []option.RequestOption) (*twiliolo.IncomingPhoneNumber, error)
-	BuyCall   int
+	LocalFn                     func(string, []option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error)
+	LocalCall                   int
+	TollFreeFn                  func(string, []option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error)
+	TollFreeCall                int
+	MobileFn                    func(string, []option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error)
+	MobileCall                  int
+	NationalFn                  func(string, []option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error)
+	NationalCall                int
+	SharedCostFn                func(string, []option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error)
+	SharedCostCall              int
+	VoipFn                      func(string, []option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error)
+	VoipCall                    int
+	MachineToMachineFn          func(string, []option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error)
+	MachineToMachineCall        int
+	SearchFn                    func(string, twiliolo.NumberType, []option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error)
+	SearchCall                  int
+	CountriesFn                 func([]option.RequestOption) ([]twiliolo.AvailablePhoneNumberCountry, error)
+	CountriesCall               int
+	BuyFn                       func(*twiliolo.AvailablePhoneNumber, []option.RequestOption) (*twiliolo.IncomingPhoneNumber, error)
+	BuyCall                     int
+	ProvisionFn                 func([]twiliolo.SearchCriterion, *twiliolo.IncomingPhoneNumberParams, []option.RequestOption) (*twiliolo.ProvisionResult, error)
+	ProvisionCall               int
+	LocalContextFn              func(context.Context, string, []option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error)
+	LocalContextCall            int
+	TollFreeContextFn           func(context.Context, string, []option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error)
+	TollFreeContextCall         int
+	MobileContextFn             func(context.Context, string, []option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error)
+	MobileContextCall           int
+	NationalContextFn           func(context.Context, string, []option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error)
+	NationalContextCall         int
+	SharedCostContextFn         func(context.Context, string, []option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error)
+	SharedCostContextCall       int
+	VoipContextFn               func(context.Context, string, []option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error)
+	VoipContextCall             int
+	MachineToMachineContextFn   func(context.Context, string, []option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error)
+	MachineToMachineContextCall int
+	SearchContextFn             func(context.Context, string, twiliolo.NumberType, []option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error)
+	SearchContextCall           int
+	CountriesContextFn          func(context.Context, []option.RequestOption) ([]twiliolo.AvailablePhoneNumberCountry, error)
+	CountriesContextCall        int
+	BuyContextFn                func(context.Context, *twiliolo.AvailablePhoneNumber, []option.RequestOption) (*twiliolo.IncomingPhoneNumber, error)
+	BuyContextCall              int
+	ProvisionContextFn          func(context.Context, []twiliolo.SearchCriterion, *twiliolo.IncomingPhoneNumberParams, []option.RequestOption) (*twiliolo.ProvisionResult, error)
+	ProvisionContextCall        int
 }
 
 // Local mocked function.
@@ -20,9 +62,149 @@ func (s *AvailablePhoneNumberService) Local(country string, requestOptions ...op
 	return s.LocalFn(country, requestOptions)
 }
 
+// TollFree mocked function.
+func (s *AvailablePhoneNumberService) TollFree(country string, requestOptions ...option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error) {
+	s.TollFreeCall++
+
+	return s.TollFreeFn(country, requestOptions)
+}
+
+// Mobile mocked function.
+func (s *AvailablePhoneNumberService) Mobile(country string, requestOptions ...option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error) {
+	s.MobileCall++
+
+	return s.MobileFn(country, requestOptions)
+}
+
+// National mocked function.
+func (s *AvailablePhoneNumberService) National(country string, requestOptions ...option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error) {
+	s.NationalCall++
+
+	return s.NationalFn(country, requestOptions)
+}
+
+// SharedCost mocked function.
+func (s *AvailablePhoneNumberService) SharedCost(country string, requestOptions ...option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error) {
+	s.SharedCostCall++
+
+	return s.SharedCostFn(country, requestOptions)
+}
+
+// Voip mocked function.
+func (s *AvailablePhoneNumberService) Voip(country string, requestOptions ...option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error) {
+	s.VoipCall++
+
+	return s.VoipFn(country, requestOptions)
+}
+
+// MachineToMachine mocked function.
+func (s *AvailablePhoneNumberService) MachineToMachine(country string, requestOptions ...option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error) {
+	s.MachineToMachineCall++
+
+	return s.MachineToMachineFn(country, requestOptions)
+}
+
+// Search mocked function.
+func (s *AvailablePhoneNumberService) Search(country string, numberType twiliolo.NumberType, requestOptions ...option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error) {
+	s.SearchCall++
+
+	return s.SearchFn(country, numberType, requestOptions)
+}
+
+// Countries mocked function.
+func (s *AvailablePhoneNumberService) Countries(requestOptions ...option.RequestOption) ([]twiliolo.AvailablePhoneNumberCountry, error) {
+	s.CountriesCall++
+
+	return s.CountriesFn(requestOptions)
+}
+
 // Buy mocked function.
 func (s *AvailablePhoneNumberService) Buy(phone *twiliolo.AvailablePhoneNumber, requestOptions ...option.RequestOption) (*twiliolo.IncomingPhoneNumber, error) {
 	s.BuyCall++
 
 	return s.BuyFn(phone, requestOptions)
 }
+
+// Provision mocked function.
+func (s *AvailablePhoneNumberService) Provision(criteria []twiliolo.SearchCriterion, params *twiliolo.IncomingPhoneNumberParams, requestOptions ...option.RequestOption) (*twiliolo.ProvisionResult, error) {
+	s.ProvisionCall++
+
+	return s.ProvisionFn(criteria, params, requestOptions)
+}
+
+// LocalContext mocked function.
+func (s *AvailablePhoneNumberService) LocalContext(ctx context.Context, country string, requestOptions ...option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error) {
+	s.LocalContextCall++
+
+	return s.LocalContextFn(ctx, country, requestOptions)
+}
+
+// TollFreeContext mocked function.
+func (s *AvailablePhoneNumberService) TollFreeContext(ctx context.Context, country string, requestOptions ...option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error) {
+	s.TollFreeContextCall++
+
+	return s.TollFreeContextFn(ctx, country, requestOptions)
+}
+
+// MobileContext mocked function.
+func (s *AvailablePhoneNumberService) MobileContext(ctx context.Context, country string, requestOptions ...option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error) {
+	s.MobileContextCall++
+
+	return s.MobileContextFn(ctx, country, requestOptions)
+}
+
+// NationalContext mocked function.
+func (s *AvailablePhoneNumberService) NationalContext(ctx context.Context, country string, requestOptions ...option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error) {
+	s.NationalContextCall++
+
+	return s.NationalContextFn(ctx, country, requestOptions)
+}
+
+// SharedCostContext mocked function.
+func (s *AvailablePhoneNumberService) SharedCostContext(ctx context.Context, country string, requestOptions ...option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error) {
+	s.SharedCostContextCall++
+
+	return s.SharedCostContextFn(ctx, country, requestOptions)
+}
+
+// VoipContext mocked function.
+func (s *AvailablePhoneNumberService) VoipContext(ctx context.Context, country string, requestOptions ...option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error) {
+	s.VoipContextCall++
+
+	return s.VoipContextFn(ctx, country, requestOptions)
+}
+
+// MachineToMachineContext mocked function.
+func (s *AvailablePhoneNumberService) MachineToMachineContext(ctx context.Context, country string, requestOptions ...option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error) {
+	s.MachineToMachineContextCall++
+
+	return s.MachineToMachineContextFn(ctx, country, requestOptions)
+}
+
+// SearchContext mocked function.
+func (s *AvailablePhoneNumberService) SearchContext(ctx context.Context, country string, numberType twiliolo.NumberType, requestOptions ...option.RequestOption) ([]twiliolo.AvailablePhoneNumber, error) {
+	s.SearchContextCall++
+
+	return s.SearchContextFn(ctx, country, numberType, requestOptions)
+}
+
+// CountriesContext mocked function.
+func (s *AvailablePhoneNumberService) CountriesContext(ctx context.Context, requestOptions ...option.RequestOption) ([]twiliolo.AvailablePhoneNumberCountry, error) {
+	s.CountriesContextCall++
+
+	return s.CountriesContextFn(ctx, requestOptions)
+}
+
+// BuyContext mocked function.
+func (s *AvailablePhoneNumberService) BuyContext(ctx context.Context, phone *twiliolo.AvailablePhoneNumber, requestOptions ...option.RequestOption) (*twiliolo.IncomingPhoneNumber, error) {
+	s.BuyContextCall++
+
+	return s.BuyContextFn(ctx, phone, requestOptions)
+}
+
+// ProvisionContext mocked function.
+func (s *AvailablePhoneNumberService) ProvisionContext(ctx context.Context, criteria []twiliolo.SearchCriterion, params *twiliolo.IncomingPhoneNumberParams, requestOptions ...option.RequestOption) (*twiliolo.ProvisionResult, error) {
+	s.ProvisionContextCall++
+
+	return s.ProvisionContextFn(ctx, criteria, params, requestOptions)
+}
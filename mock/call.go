@@ -0,0 +1,138 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/option"
+)
+
+// CallService is the mock of a CallService
+type CallService struct {
+	CreateFn                func(*twiliolo.CallParams, []option.RequestOption) (*twiliolo.Call, error)
+	CreateCall              int
+	GetFn                   func(string, []option.RequestOption) (*twiliolo.Call, error)
+	GetCall                 int
+	UpdateFn                func(string, *twiliolo.CallUpdateParams, []option.RequestOption) (*twiliolo.Call, error)
+	UpdateCall              int
+	DeleteFn                func(string, []option.RequestOption) error
+	DeleteCall              int
+	ListFn                  func([]option.RequestOption) (*twiliolo.CallList, error)
+	ListCall                int
+	ListNextPageFn          func(*twiliolo.CallList) (*twiliolo.CallList, error)
+	ListNextPageCall        int
+	IterFn                  func([]option.RequestOption) *twiliolo.Iterator[*twiliolo.Call]
+	IterCall                int
+	CreateContextFn         func(context.Context, *twiliolo.CallParams, []option.RequestOption) (*twiliolo.Call, error)
+	CreateContextCall       int
+	GetContextFn            func(context.Context, string, []option.RequestOption) (*twiliolo.Call, error)
+	GetContextCall          int
+	UpdateContextFn         func(context.Context, string, *twiliolo.CallUpdateParams, []option.RequestOption) (*twiliolo.Call, error)
+	UpdateContextCall       int
+	DeleteContextFn         func(context.Context, string, []option.RequestOption) error
+	DeleteContextCall       int
+	ListContextFn           func(context.Context, []option.RequestOption) (*twiliolo.CallList, error)
+	ListContextCall         int
+	ListNextPageContextFn   func(context.Context, *twiliolo.CallList) (*twiliolo.CallList, error)
+	ListNextPageContextCall int
+	IterContextFn           func(context.Context, []option.RequestOption) *twiliolo.Iterator[*twiliolo.Call]
+	IterContextCall         int
+}
+
+// Create mocked function.
+func (s *CallService) Create(params *twiliolo.CallParams, requestOptions ...option.RequestOption) (*twiliolo.Call, error) {
+	s.CreateCall++
+
+	return s.CreateFn(params, requestOptions)
+}
+
+// Get mocked function.
+func (s *CallService) Get(sid string, requestOptions ...option.RequestOption) (*twiliolo.Call, error) {
+	s.GetCall++
+
+	return s.GetFn(sid, requestOptions)
+}
+
+// Update mocked function.
+func (s *CallService) Update(sid string, params *twiliolo.CallUpdateParams, requestOptions ...option.RequestOption) (*twiliolo.Call, error) {
+	s.UpdateCall++
+
+	return s.UpdateFn(sid, params, requestOptions)
+}
+
+// Delete mocked function.
+func (s *CallService) Delete(sid string, requestOptions ...option.RequestOption) error {
+	s.DeleteCall++
+
+	return s.DeleteFn(sid, requestOptions)
+}
+
+// List mocked function.
+func (s *CallService) List(requestOptions ...option.RequestOption) (*twiliolo.CallList, error) {
+	s.ListCall++
+
+	return s.ListFn(requestOptions)
+}
+
+// ListNextPage mocked function.
+func (s *CallService) ListNextPage(previousList *twiliolo.CallList) (*twiliolo.CallList, error) {
+	s.ListNextPageCall++
+
+	return s.ListNextPageFn(previousList)
+}
+
+// Iter mocked function.
+func (s *CallService) Iter(requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Call] {
+	s.IterCall++
+
+	return s.IterFn(requestOptions)
+}
+
+// CreateContext mocked function.
+func (s *CallService) CreateContext(ctx context.Context, params *twiliolo.CallParams, requestOptions ...option.RequestOption) (*twiliolo.Call, error) {
+	s.CreateContextCall++
+
+	return s.CreateContextFn(ctx, params, requestOptions)
+}
+
+// GetContext mocked function.
+func (s *CallService) GetContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*twiliolo.Call, error) {
+	s.GetContextCall++
+
+	return s.GetContextFn(ctx, sid, requestOptions)
+}
+
+// UpdateContext mocked function.
+func (s *CallService) UpdateContext(ctx context.Context, sid string, params *twiliolo.CallUpdateParams, requestOptions ...option.RequestOption) (*twiliolo.Call, error) {
+	s.UpdateContextCall++
+
+	return s.UpdateContextFn(ctx, sid, params, requestOptions)
+}
+
+// DeleteContext mocked function.
+func (s *CallService) DeleteContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) error {
+	s.DeleteContextCall++
+
+	return s.DeleteContextFn(ctx, sid, requestOptions)
+}
+
+// ListContext mocked function.
+func (s *CallService) ListContext(ctx context.Context, requestOptions ...option.RequestOption) (*twiliolo.CallList, error) {
+	s.ListContextCall++
+
+	return s.ListContextFn(ctx, requestOptions)
+}
+
+// ListNextPageContext mocked function.
+func (s *CallService) ListNextPageContext(ctx context.Context, previousList *twiliolo.CallList) (*twiliolo.CallList, error) {
+	s.ListNextPageContextCall++
+
+	return s.ListNextPageContextFn(ctx, previousList)
+}
+
+// IterContext mocked function.
+func (s *CallService) IterContext(ctx context.Context, requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Call] {
+	s.IterContextCall++
+
+	return s.IterContextFn(ctx, requestOptions)
+}
@@ -7,6 +7,18 @@ func NewMockClient() *twiliolo.TwilioClient {
 	c := twiliolo.TwilioClient{}
 	c.IncomingPhoneNumber = &IncomingPhoneNumberService{}
 	c.AvailablePhoneNumber = &AvailablePhoneNumberService{}
+	c.Message = &MessageService{}
+	c.Call = &CallService{}
+	c.Account = &AccountService{}
+	c.Key = &KeyService{}
+	c.Recording = &RecordingService{}
+	c.Transcription = &TranscriptionService{}
+	c.Conference = &ConferenceService{}
+	c.Participant = &ParticipantService{}
+	c.Queue = &QueueService{}
+	c.QueueMember = &QueueMemberService{}
+	c.Application = &ApplicationService{}
+	c.OutgoingCallerID = &OutgoingCallerIDService{}
 
 	return &c
 }
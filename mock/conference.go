@@ -0,0 +1,102 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/option"
+)
+
+// ConferenceService is the mock of a ConferenceService
+type ConferenceService struct {
+	GetFn                   func(string, []option.RequestOption) (*twiliolo.Conference, error)
+	GetCall                 int
+	EndFn                   func(string, []option.RequestOption) (*twiliolo.Conference, error)
+	EndCall                 int
+	ListFn                  func([]option.RequestOption) (*twiliolo.ConferenceList, error)
+	ListCall                int
+	ListNextPageFn          func(*twiliolo.ConferenceList) (*twiliolo.ConferenceList, error)
+	ListNextPageCall        int
+	IterFn                  func([]option.RequestOption) *twiliolo.Iterator[*twiliolo.Conference]
+	IterCall                int
+	GetContextFn            func(context.Context, string, []option.RequestOption) (*twiliolo.Conference, error)
+	GetContextCall          int
+	EndContextFn            func(context.Context, string, []option.RequestOption) (*twiliolo.Conference, error)
+	EndContextCall          int
+	ListContextFn           func(context.Context, []option.RequestOption) (*twiliolo.ConferenceList, error)
+	ListContextCall         int
+	ListNextPageContextFn   func(context.Context, *twiliolo.ConferenceList) (*twiliolo.ConferenceList, error)
+	ListNextPageContextCall int
+	IterContextFn           func(context.Context, []option.RequestOption) *twiliolo.Iterator[*twiliolo.Conference]
+	IterContextCall         int
+}
+
+// Get mocked function.
+func (s *ConferenceService) Get(sid string, requestOptions ...option.RequestOption) (*twiliolo.Conference, error) {
+	s.GetCall++
+
+	return s.GetFn(sid, requestOptions)
+}
+
+// End mocked function.
+func (s *ConferenceService) End(sid string, requestOptions ...option.RequestOption) (*twiliolo.Conference, error) {
+	s.EndCall++
+
+	return s.EndFn(sid, requestOptions)
+}
+
+// List mocked function.
+func (s *ConferenceService) List(requestOptions ...option.RequestOption) (*twiliolo.ConferenceList, error) {
+	s.ListCall++
+
+	return s.ListFn(requestOptions)
+}
+
+// ListNextPage mocked function.
+func (s *ConferenceService) ListNextPage(previousList *twiliolo.ConferenceList) (*twiliolo.ConferenceList, error) {
+	s.ListNextPageCall++
+
+	return s.ListNextPageFn(previousList)
+}
+
+// Iter mocked function.
+func (s *ConferenceService) Iter(requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Conference] {
+	s.IterCall++
+
+	return s.IterFn(requestOptions)
+}
+
+// GetContext mocked function.
+func (s *ConferenceService) GetContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*twiliolo.Conference, error) {
+	s.GetContextCall++
+
+	return s.GetContextFn(ctx, sid, requestOptions)
+}
+
+// EndContext mocked function.
+func (s *ConferenceService) EndContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*twiliolo.Conference, error) {
+	s.EndContextCall++
+
+	return s.EndContextFn(ctx, sid, requestOptions)
+}
+
+// ListContext mocked function.
+func (s *ConferenceService) ListContext(ctx context.Context, requestOptions ...option.RequestOption) (*twiliolo.ConferenceList, error) {
+	s.ListContextCall++
+
+	return s.ListContextFn(ctx, requestOptions)
+}
+
+// ListNextPageContext mocked function.
+func (s *ConferenceService) ListNextPageContext(ctx context.Context, previousList *twiliolo.ConferenceList) (*twiliolo.ConferenceList, error) {
+	s.ListNextPageContextCall++
+
+	return s.ListNextPageContextFn(ctx, previousList)
+}
+
+// IterContext mocked function.
+func (s *ConferenceService) IterContext(ctx context.Context, requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Conference] {
+	s.IterContextCall++
+
+	return s.IterContextFn(ctx, requestOptions)
+}
@@ -1,22 +1,58 @@
 package mock
 
 import (
+	"context"
+
 	"github.com/genesor/twiliolo"
 	"github.com/genesor/twiliolo/option"
 )
 
 // IncomingPhoneNumberService is the mock of a IncomingPhoneNumberService
 type IncomingPhoneNumberService struct {
-	GetFn            func(string, []option.RequestOption) (*twiliolo.IncomingPhoneNumber, error)
-	GetCall          int
-	UpdateFn         func(*twiliolo.IncomingPhoneNumber, []option.RequestOption) error
-	UpdateCall       int
-	AllFn            func() ([]*twiliolo.IncomingPhoneNumber, error)
-	AllCall          int
-	ListFn           func([]option.RequestOption) (*twiliolo.IncomingPhoneNumberList, error)
-	ListCall         int
-	ListNextPageFn   func(*twiliolo.IncomingPhoneNumberList, []option.RequestOption) (*twiliolo.IncomingPhoneNumberList, error)
-	ListNextPageCall int
+	GetFn                        func(string, []option.RequestOption) (*twiliolo.IncomingPhoneNumber, error)
+	GetCall                      int
+	UpdateFn                     func(string, *twiliolo.IncomingPhoneNumberUpdate, []option.RequestOption) (*twiliolo.IncomingPhoneNumber, error)
+	UpdateCall                   int
+	TransferToAccountFn          func(string, string, []option.RequestOption) (*twiliolo.IncomingPhoneNumber, error)
+	TransferToAccountCall        int
+	CreateFn                     func(*twiliolo.IncomingPhoneNumberParams, []option.RequestOption) (*twiliolo.IncomingPhoneNumber, error)
+	CreateCall                   int
+	DeleteFn                     func(string, []option.RequestOption) error
+	DeleteCall                   int
+	AllFn                        func() ([]*twiliolo.IncomingPhoneNumber, error)
+	AllCall                      int
+	ListFn                       func([]option.RequestOption) (*twiliolo.IncomingPhoneNumberList, error)
+	ListCall                     int
+	ListNextPageFn               func(*twiliolo.IncomingPhoneNumberList, []option.RequestOption) (*twiliolo.IncomingPhoneNumberList, error)
+	ListNextPageCall             int
+	IterFn                       func([]option.RequestOption) *twiliolo.Iterator[*twiliolo.IncomingPhoneNumber]
+	IterCall                     int
+	ListByTypeFn                 func(twiliolo.NumberType, []option.RequestOption) (*twiliolo.IncomingPhoneNumberList, error)
+	ListByTypeCall               int
+	IterByTypeFn                 func(twiliolo.NumberType, []option.RequestOption) *twiliolo.Iterator[*twiliolo.IncomingPhoneNumber]
+	IterByTypeCall               int
+	GetContextFn                 func(context.Context, string, []option.RequestOption) (*twiliolo.IncomingPhoneNumber, error)
+	GetContextCall               int
+	UpdateContextFn              func(context.Context, string, *twiliolo.IncomingPhoneNumberUpdate, []option.RequestOption) (*twiliolo.IncomingPhoneNumber, error)
+	UpdateContextCall            int
+	TransferToAccountContextFn   func(context.Context, string, string, []option.RequestOption) (*twiliolo.IncomingPhoneNumber, error)
+	TransferToAccountContextCall int
+	CreateContextFn              func(context.Context, *twiliolo.IncomingPhoneNumberParams, []option.RequestOption) (*twiliolo.IncomingPhoneNumber, error)
+	CreateContextCall            int
+	DeleteContextFn              func(context.Context, string, []option.RequestOption) error
+	DeleteContextCall            int
+	AllContextFn                 func(context.Context) ([]*twiliolo.IncomingPhoneNumber, error)
+	AllContextCall               int
+	ListContextFn                func(context.Context, []option.RequestOption) (*twiliolo.IncomingPhoneNumberList, error)
+	ListContextCall              int
+	ListNextPageContextFn        func(context.Context, *twiliolo.IncomingPhoneNumberList, []option.RequestOption) (*twiliolo.IncomingPhoneNumberList, error)
+	ListNextPageContextCall      int
+	IterContextFn                func(context.Context, []option.RequestOption) *twiliolo.Iterator[*twiliolo.IncomingPhoneNumber]
+	IterContextCall              int
+	ListByTypeContextFn          func(context.Context, twiliolo.NumberType, []option.RequestOption) (*twiliolo.IncomingPhoneNumberList, error)
+	ListByTypeContextCall        int
+	IterByTypeContextFn          func(context.Context, twiliolo.NumberType, []option.RequestOption) *twiliolo.Iterator[*twiliolo.IncomingPhoneNumber]
+	IterByTypeContextCall        int
 }
 
 // Get mocked function.
@@ -27,10 +63,31 @@ func (s *IncomingPhoneNumberService) Get(sid string, requestOptions ...option.Re
 }
 
 // Update mocked function.
-func (s *IncomingPhoneNumberService) Update(incomingPhoneNumber *twiliolo.IncomingPhoneNumber, requestOptions ...option.RequestOption) error {
+func (s *IncomingPhoneNumberService) Update(sid string, update *twiliolo.IncomingPhoneNumberUpdate, requestOptions ...option.RequestOption) (*twiliolo.IncomingPhoneNumber, error) {
 	s.UpdateCall++
 
-	return s.UpdateFn(incomingPhoneNumber, requestOptions)
+	return s.UpdateFn(sid, update, requestOptions)
+}
+
+// TransferToAccount mocked function.
+func (s *IncomingPhoneNumberService) TransferToAccount(sid string, accountSid string, requestOptions ...option.RequestOption) (*twiliolo.IncomingPhoneNumber, error) {
+	s.TransferToAccountCall++
+
+	return s.TransferToAccountFn(sid, accountSid, requestOptions)
+}
+
+// Create mocked function.
+func (s *IncomingPhoneNumberService) Create(params *twiliolo.IncomingPhoneNumberParams, requestOptions ...option.RequestOption) (*twiliolo.IncomingPhoneNumber, error) {
+	s.CreateCall++
+
+	return s.CreateFn(params, requestOptions)
+}
+
+// Delete mocked function.
+func (s *IncomingPhoneNumberService) Delete(sid string, requestOptions ...option.RequestOption) error {
+	s.DeleteCall++
+
+	return s.DeleteFn(sid, requestOptions)
 }
 
 // All mocked function.
@@ -53,3 +110,101 @@ func (s *IncomingPhoneNumberService) ListNextPage(previousList *twiliolo.Incomin
 
 	return s.ListNextPageFn(previousList, requestOptions)
 }
+
+// Iter mocked function.
+func (s *IncomingPhoneNumberService) Iter(requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.IncomingPhoneNumber] {
+	s.IterCall++
+
+	return s.IterFn(requestOptions)
+}
+
+// ListByType mocked function.
+func (s *IncomingPhoneNumberService) ListByType(numberType twiliolo.NumberType, requestOptions ...option.RequestOption) (*twiliolo.IncomingPhoneNumberList, error) {
+	s.ListByTypeCall++
+
+	return s.ListByTypeFn(numberType, requestOptions)
+}
+
+// IterByType mocked function.
+func (s *IncomingPhoneNumberService) IterByType(numberType twiliolo.NumberType, requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.IncomingPhoneNumber] {
+	s.IterByTypeCall++
+
+	return s.IterByTypeFn(numberType, requestOptions)
+}
+
+// GetContext mocked function.
+func (s *IncomingPhoneNumberService) GetContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*twiliolo.IncomingPhoneNumber, error) {
+	s.GetContextCall++
+
+	return s.GetContextFn(ctx, sid, requestOptions)
+}
+
+// UpdateContext mocked function.
+func (s *IncomingPhoneNumberService) UpdateContext(ctx context.Context, sid string, update *twiliolo.IncomingPhoneNumberUpdate, requestOptions ...option.RequestOption) (*twiliolo.IncomingPhoneNumber, error) {
+	s.UpdateContextCall++
+
+	return s.UpdateContextFn(ctx, sid, update, requestOptions)
+}
+
+// TransferToAccountContext mocked function.
+func (s *IncomingPhoneNumberService) TransferToAccountContext(ctx context.Context, sid string, accountSid string, requestOptions ...option.RequestOption) (*twiliolo.IncomingPhoneNumber, error) {
+	s.TransferToAccountContextCall++
+
+	return s.TransferToAccountContextFn(ctx, sid, accountSid, requestOptions)
+}
+
+// CreateContext mocked function.
+func (s *IncomingPhoneNumberService) CreateContext(ctx context.Context, params *twiliolo.IncomingPhoneNumberParams, requestOptions ...option.RequestOption) (*twiliolo.IncomingPhoneNumber, error) {
+	s.CreateContextCall++
+
+	return s.CreateContextFn(ctx, params, requestOptions)
+}
+
+// DeleteContext mocked function.
+func (s *IncomingPhoneNumberService) DeleteContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) error {
+	s.DeleteContextCall++
+
+	return s.DeleteContextFn(ctx, sid, requestOptions)
+}
+
+// AllContext mocked function.
+func (s *IncomingPhoneNumberService) AllContext(ctx context.Context) ([]*twiliolo.IncomingPhoneNumber, error) {
+	s.AllContextCall++
+
+	return s.AllContextFn(ctx)
+}
+
+// ListContext mocked function.
+func (s *IncomingPhoneNumberService) ListContext(ctx context.Context, requestOptions ...option.RequestOption) (*twiliolo.IncomingPhoneNumberList, error) {
+	s.ListContextCall++
+
+	return s.ListContextFn(ctx, requestOptions)
+}
+
+// ListNextPageContext mocked function.
+func (s *IncomingPhoneNumberService) ListNextPageContext(ctx context.Context, previousList *twiliolo.IncomingPhoneNumberList, requestOptions ...option.RequestOption) (*twiliolo.IncomingPhoneNumberList, error) {
+	s.ListNextPageContextCall++
+
+	return s.ListNextPageContextFn(ctx, previousList, requestOptions)
+}
+
+// IterContext mocked function.
+func (s *IncomingPhoneNumberService) IterContext(ctx context.Context, requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.IncomingPhoneNumber] {
+	s.IterContextCall++
+
+	return s.IterContextFn(ctx, requestOptions)
+}
+
+// ListByTypeContext mocked function.
+func (s *IncomingPhoneNumberService) ListByTypeContext(ctx context.Context, numberType twiliolo.NumberType, requestOptions ...option.RequestOption) (*twiliolo.IncomingPhoneNumberList, error) {
+	s.ListByTypeContextCall++
+
+	return s.ListByTypeContextFn(ctx, numberType, requestOptions)
+}
+
+// IterByTypeContext mocked function.
+func (s *IncomingPhoneNumberService) IterByTypeContext(ctx context.Context, numberType twiliolo.NumberType, requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.IncomingPhoneNumber] {
+	s.IterByTypeContextCall++
+
+	return s.IterByTypeContextFn(ctx, numberType, requestOptions)
+}
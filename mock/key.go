@@ -0,0 +1,120 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/option"
+)
+
+// KeyService is the mock of a KeyService
+type KeyService struct {
+	CreateFn                func(string, []option.RequestOption) (*twiliolo.Key, error)
+	CreateCall              int
+	GetFn                   func(string, []option.RequestOption) (*twiliolo.Key, error)
+	GetCall                 int
+	DeleteFn                func(string, []option.RequestOption) error
+	DeleteCall              int
+	ListFn                  func([]option.RequestOption) (*twiliolo.KeyList, error)
+	ListCall                int
+	ListNextPageFn          func(*twiliolo.KeyList) (*twiliolo.KeyList, error)
+	ListNextPageCall        int
+	IterFn                  func([]option.RequestOption) *twiliolo.Iterator[*twiliolo.Key]
+	IterCall                int
+	CreateContextFn         func(context.Context, string, []option.RequestOption) (*twiliolo.Key, error)
+	CreateContextCall       int
+	GetContextFn            func(context.Context, string, []option.RequestOption) (*twiliolo.Key, error)
+	GetContextCall          int
+	DeleteContextFn         func(context.Context, string, []option.RequestOption) error
+	DeleteContextCall       int
+	ListContextFn           func(context.Context, []option.RequestOption) (*twiliolo.KeyList, error)
+	ListContextCall         int
+	ListNextPageContextFn   func(context.Context, *twiliolo.KeyList) (*twiliolo.KeyList, error)
+	ListNextPageContextCall int
+	IterContextFn           func(context.Context, []option.RequestOption) *twiliolo.Iterator[*twiliolo.Key]
+	IterContextCall         int
+}
+
+// Create mocked function.
+func (s *KeyService) Create(friendlyName string, requestOptions ...option.RequestOption) (*twiliolo.Key, error) {
+	s.CreateCall++
+
+	return s.CreateFn(friendlyName, requestOptions)
+}
+
+// Get mocked function.
+func (s *KeyService) Get(sid string, requestOptions ...option.RequestOption) (*twiliolo.Key, error) {
+	s.GetCall++
+
+	return s.GetFn(sid, requestOptions)
+}
+
+// Delete mocked function.
+func (s *KeyService) Delete(sid string, requestOptions ...option.RequestOption) error {
+	s.DeleteCall++
+
+	return s.DeleteFn(sid, requestOptions)
+}
+
+// List mocked function.
+func (s *KeyService) List(requestOptions ...option.RequestOption) (*twiliolo.KeyList, error) {
+	s.ListCall++
+
+	return s.ListFn(requestOptions)
+}
+
+// ListNextPage mocked function.
+func (s *KeyService) ListNextPage(previousList *twiliolo.KeyList) (*twiliolo.KeyList, error) {
+	s.ListNextPageCall++
+
+	return s.ListNextPageFn(previousList)
+}
+
+// Iter mocked function.
+func (s *KeyService) Iter(requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Key] {
+	s.IterCall++
+
+	return s.IterFn(requestOptions)
+}
+
+// CreateContext mocked function.
+func (s *KeyService) CreateContext(ctx context.Context, friendlyName string, requestOptions ...option.RequestOption) (*twiliolo.Key, error) {
+	s.CreateContextCall++
+
+	return s.CreateContextFn(ctx, friendlyName, requestOptions)
+}
+
+// GetContext mocked function.
+func (s *KeyService) GetContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*twiliolo.Key, error) {
+	s.GetContextCall++
+
+	return s.GetContextFn(ctx, sid, requestOptions)
+}
+
+// DeleteContext mocked function.
+func (s *KeyService) DeleteContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) error {
+	s.DeleteContextCall++
+
+	return s.DeleteContextFn(ctx, sid, requestOptions)
+}
+
+// ListContext mocked function.
+func (s *KeyService) ListContext(ctx context.Context, requestOptions ...option.RequestOption) (*twiliolo.KeyList, error) {
+	s.ListContextCall++
+
+	return s.ListContextFn(ctx, requestOptions)
+}
+
+// ListNextPageContext mocked function.
+func (s *KeyService) ListNextPageContext(ctx context.Context, previousList *twiliolo.KeyList) (*twiliolo.KeyList, error) {
+	s.ListNextPageContextCall++
+
+	return s.ListNextPageContextFn(ctx, previousList)
+}
+
+// IterContext mocked function.
+func (s *KeyService) IterContext(ctx context.Context, requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Key] {
+	s.IterContextCall++
+
+	return s.IterContextFn(ctx, requestOptions)
+}
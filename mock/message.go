@@ -0,0 +1,138 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/option"
+)
+
+// MessageService is the mock of a MessageService
+type MessageService struct {
+	CreateFn                func(*twiliolo.MessageParams, []option.RequestOption) (*twiliolo.Message, error)
+	CreateCall              int
+	GetFn                   func(string, []option.RequestOption) (*twiliolo.Message, error)
+	GetCall                 int
+	RedactFn                func(string, []option.RequestOption) (*twiliolo.Message, error)
+	RedactCall              int
+	DeleteFn                func(string, []option.RequestOption) error
+	DeleteCall              int
+	ListFn                  func([]option.RequestOption) (*twiliolo.MessageList, error)
+	ListCall                int
+	ListNextPageFn          func(*twiliolo.MessageList) (*twiliolo.MessageList, error)
+	ListNextPageCall        int
+	IterFn                  func([]option.RequestOption) *twiliolo.Iterator[*twiliolo.Message]
+	IterCall                int
+	CreateContextFn         func(context.Context, *twiliolo.MessageParams, []option.RequestOption) (*twiliolo.Message, error)
+	CreateContextCall       int
+	GetContextFn            func(context.Context, string, []option.RequestOption) (*twiliolo.Message, error)
+	GetContextCall          int
+	RedactContextFn         func(context.Context, string, []option.RequestOption) (*twiliolo.Message, error)
+	RedactContextCall       int
+	DeleteContextFn         func(context.Context, string, []option.RequestOption) error
+	DeleteContextCall       int
+	ListContextFn           func(context.Context, []option.RequestOption) (*twiliolo.MessageList, error)
+	ListContextCall         int
+	ListNextPageContextFn   func(context.Context, *twiliolo.MessageList) (*twiliolo.MessageList, error)
+	ListNextPageContextCall int
+	IterContextFn           func(context.Context, []option.RequestOption) *twiliolo.Iterator[*twiliolo.Message]
+	IterContextCall         int
+}
+
+// Create mocked function.
+func (s *MessageService) Create(params *twiliolo.MessageParams, requestOptions ...option.RequestOption) (*twiliolo.Message, error) {
+	s.CreateCall++
+
+	return s.CreateFn(params, requestOptions)
+}
+
+// Get mocked function.
+func (s *MessageService) Get(sid string, requestOptions ...option.RequestOption) (*twiliolo.Message, error) {
+	s.GetCall++
+
+	return s.GetFn(sid, requestOptions)
+}
+
+// Redact mocked function.
+func (s *MessageService) Redact(sid string, requestOptions ...option.RequestOption) (*twiliolo.Message, error) {
+	s.RedactCall++
+
+	return s.RedactFn(sid, requestOptions)
+}
+
+// Delete mocked function.
+func (s *MessageService) Delete(sid string, requestOptions ...option.RequestOption) error {
+	s.DeleteCall++
+
+	return s.DeleteFn(sid, requestOptions)
+}
+
+// List mocked function.
+func (s *MessageService) List(requestOptions ...option.RequestOption) (*twiliolo.MessageList, error) {
+	s.ListCall++
+
+	return s.ListFn(requestOptions)
+}
+
+// ListNextPage mocked function.
+func (s *MessageService) ListNextPage(previousList *twiliolo.MessageList) (*twiliolo.MessageList, error) {
+	s.ListNextPageCall++
+
+	return s.ListNextPageFn(previousList)
+}
+
+// Iter mocked function.
+func (s *MessageService) Iter(requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Message] {
+	s.IterCall++
+
+	return s.IterFn(requestOptions)
+}
+
+// CreateContext mocked function.
+func (s *MessageService) CreateContext(ctx context.Context, params *twiliolo.MessageParams, requestOptions ...option.RequestOption) (*twiliolo.Message, error) {
+	s.CreateContextCall++
+
+	return s.CreateContextFn(ctx, params, requestOptions)
+}
+
+// GetContext mocked function.
+func (s *MessageService) GetContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*twiliolo.Message, error) {
+	s.GetContextCall++
+
+	return s.GetContextFn(ctx, sid, requestOptions)
+}
+
+// RedactContext mocked function.
+func (s *MessageService) RedactContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*twiliolo.Message, error) {
+	s.RedactContextCall++
+
+	return s.RedactContextFn(ctx, sid, requestOptions)
+}
+
+// DeleteContext mocked function.
+func (s *MessageService) DeleteContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) error {
+	s.DeleteContextCall++
+
+	return s.DeleteContextFn(ctx, sid, requestOptions)
+}
+
+// ListContext mocked function.
+func (s *MessageService) ListContext(ctx context.Context, requestOptions ...option.RequestOption) (*twiliolo.MessageList, error) {
+	s.ListContextCall++
+
+	return s.ListContextFn(ctx, requestOptions)
+}
+
+// ListNextPageContext mocked function.
+func (s *MessageService) ListNextPageContext(ctx context.Context, previousList *twiliolo.MessageList) (*twiliolo.MessageList, error) {
+	s.ListNextPageContextCall++
+
+	return s.ListNextPageContextFn(ctx, previousList)
+}
+
+// IterContext mocked function.
+func (s *MessageService) IterContext(ctx context.Context, requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Message] {
+	s.IterContextCall++
+
+	return s.IterContextFn(ctx, requestOptions)
+}
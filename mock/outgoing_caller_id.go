@@ -0,0 +1,138 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/option"
+)
+
+// OutgoingCallerIDService is the mock of a OutgoingCallerIDService
+type OutgoingCallerIDService struct {
+	ValidateFn              func(*twiliolo.ValidationRequestParams, []option.RequestOption) (*twiliolo.ValidationRequest, error)
+	ValidateCall            int
+	GetFn                   func(string, []option.RequestOption) (*twiliolo.OutgoingCallerID, error)
+	GetCall                 int
+	RenameFn                func(string, string, []option.RequestOption) (*twiliolo.OutgoingCallerID, error)
+	RenameCall              int
+	DeleteFn                func(string, []option.RequestOption) error
+	DeleteCall              int
+	ListFn                  func([]option.RequestOption) (*twiliolo.OutgoingCallerIDList, error)
+	ListCall                int
+	ListNextPageFn          func(*twiliolo.OutgoingCallerIDList) (*twiliolo.OutgoingCallerIDList, error)
+	ListNextPageCall        int
+	IterFn                  func([]option.RequestOption) *twiliolo.Iterator[*twiliolo.OutgoingCallerID]
+	IterCall                int
+	ValidateContextFn       func(context.Context, *twiliolo.ValidationRequestParams, []option.RequestOption) (*twiliolo.ValidationRequest, error)
+	ValidateContextCall     int
+	GetContextFn            func(context.Context, string, []option.RequestOption) (*twiliolo.OutgoingCallerID, error)
+	GetContextCall          int
+	RenameContextFn         func(context.Context, string, string, []option.RequestOption) (*twiliolo.OutgoingCallerID, error)
+	RenameContextCall       int
+	DeleteContextFn         func(context.Context, string, []option.RequestOption) error
+	DeleteContextCall       int
+	ListContextFn           func(context.Context, []option.RequestOption) (*twiliolo.OutgoingCallerIDList, error)
+	ListContextCall         int
+	ListNextPageContextFn   func(context.Context, *twiliolo.OutgoingCallerIDList) (*twiliolo.OutgoingCallerIDList, error)
+	ListNextPageContextCall int
+	IterContextFn           func(context.Context, []option.RequestOption) *twiliolo.Iterator[*twiliolo.OutgoingCallerID]
+	IterContextCall         int
+}
+
+// Validate mocked function.
+func (s *OutgoingCallerIDService) Validate(params *twiliolo.ValidationRequestParams, requestOptions ...option.RequestOption) (*twiliolo.ValidationRequest, error) {
+	s.ValidateCall++
+
+	return s.ValidateFn(params, requestOptions)
+}
+
+// Get mocked function.
+func (s *OutgoingCallerIDService) Get(sid string, requestOptions ...option.RequestOption) (*twiliolo.OutgoingCallerID, error) {
+	s.GetCall++
+
+	return s.GetFn(sid, requestOptions)
+}
+
+// Rename mocked function.
+func (s *OutgoingCallerIDService) Rename(sid string, friendlyName string, requestOptions ...option.RequestOption) (*twiliolo.OutgoingCallerID, error) {
+	s.RenameCall++
+
+	return s.RenameFn(sid, friendlyName, requestOptions)
+}
+
+// Delete mocked function.
+func (s *OutgoingCallerIDService) Delete(sid string, requestOptions ...option.RequestOption) error {
+	s.DeleteCall++
+
+	return s.DeleteFn(sid, requestOptions)
+}
+
+// List mocked function.
+func (s *OutgoingCallerIDService) List(requestOptions ...option.RequestOption) (*twiliolo.OutgoingCallerIDList, error) {
+	s.ListCall++
+
+	return s.ListFn(requestOptions)
+}
+
+// ListNextPage mocked function.
+func (s *OutgoingCallerIDService) ListNextPage(previousList *twiliolo.OutgoingCallerIDList) (*twiliolo.OutgoingCallerIDList, error) {
+	s.ListNextPageCall++
+
+	return s.ListNextPageFn(previousList)
+}
+
+// Iter mocked function.
+func (s *OutgoingCallerIDService) Iter(requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.OutgoingCallerID] {
+	s.IterCall++
+
+	return s.IterFn(requestOptions)
+}
+
+// ValidateContext mocked function.
+func (s *OutgoingCallerIDService) ValidateContext(ctx context.Context, params *twiliolo.ValidationRequestParams, requestOptions ...option.RequestOption) (*twiliolo.ValidationRequest, error) {
+	s.ValidateContextCall++
+
+	return s.ValidateContextFn(ctx, params, requestOptions)
+}
+
+// GetContext mocked function.
+func (s *OutgoingCallerIDService) GetContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*twiliolo.OutgoingCallerID, error) {
+	s.GetContextCall++
+
+	return s.GetContextFn(ctx, sid, requestOptions)
+}
+
+// RenameContext mocked function.
+func (s *OutgoingCallerIDService) RenameContext(ctx context.Context, sid string, friendlyName string, requestOptions ...option.RequestOption) (*twiliolo.OutgoingCallerID, error) {
+	s.RenameContextCall++
+
+	return s.RenameContextFn(ctx, sid, friendlyName, requestOptions)
+}
+
+// DeleteContext mocked function.
+func (s *OutgoingCallerIDService) DeleteContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) error {
+	s.DeleteContextCall++
+
+	return s.DeleteContextFn(ctx, sid, requestOptions)
+}
+
+// ListContext mocked function.
+func (s *OutgoingCallerIDService) ListContext(ctx context.Context, requestOptions ...option.RequestOption) (*twiliolo.OutgoingCallerIDList, error) {
+	s.ListContextCall++
+
+	return s.ListContextFn(ctx, requestOptions)
+}
+
+// ListNextPageContext mocked function.
+func (s *OutgoingCallerIDService) ListNextPageContext(ctx context.Context, previousList *twiliolo.OutgoingCallerIDList) (*twiliolo.OutgoingCallerIDList, error) {
+	s.ListNextPageContextCall++
+
+	return s.ListNextPageContextFn(ctx, previousList)
+}
+
+// IterContext mocked function.
+func (s *OutgoingCallerIDService) IterContext(ctx context.Context, requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.OutgoingCallerID] {
+	s.IterContextCall++
+
+	return s.IterContextFn(ctx, requestOptions)
+}
@@ -0,0 +1,228 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/option"
+)
+
+// ParticipantService is the mock of a ParticipantService
+type ParticipantService struct {
+	CreateFn                func(string, *twiliolo.ParticipantParams, []option.RequestOption) (*twiliolo.Participant, error)
+	CreateCall              int
+	GetFn                   func(string, string, []option.RequestOption) (*twiliolo.Participant, error)
+	GetCall                 int
+	UpdateFn                func(string, string, *twiliolo.ParticipantUpdate, []option.RequestOption) (*twiliolo.Participant, error)
+	UpdateCall              int
+	MuteFn                  func(string, string, []option.RequestOption) (*twiliolo.Participant, error)
+	MuteCall                int
+	UnmuteFn                func(string, string, []option.RequestOption) (*twiliolo.Participant, error)
+	UnmuteCall              int
+	HoldFn                  func(string, string, string, []option.RequestOption) (*twiliolo.Participant, error)
+	HoldCall                int
+	UnholdFn                func(string, string, []option.RequestOption) (*twiliolo.Participant, error)
+	UnholdCall              int
+	CoachFn                 func(string, string, string, []option.RequestOption) (*twiliolo.Participant, error)
+	CoachCall               int
+	KickFn                  func(string, string, []option.RequestOption) error
+	KickCall                int
+	ListFn                  func(string, []option.RequestOption) (*twiliolo.ParticipantList, error)
+	ListCall                int
+	ListNextPageFn          func(*twiliolo.ParticipantList) (*twiliolo.ParticipantList, error)
+	ListNextPageCall        int
+	IterFn                  func(string, []option.RequestOption) *twiliolo.Iterator[*twiliolo.Participant]
+	IterCall                int
+	CreateContextFn         func(context.Context, string, *twiliolo.ParticipantParams, []option.RequestOption) (*twiliolo.Participant, error)
+	CreateContextCall       int
+	GetContextFn            func(context.Context, string, string, []option.RequestOption) (*twiliolo.Participant, error)
+	GetContextCall          int
+	UpdateContextFn         func(context.Context, string, string, *twiliolo.ParticipantUpdate, []option.RequestOption) (*twiliolo.Participant, error)
+	UpdateContextCall       int
+	MuteContextFn           func(context.Context, string, string, []option.RequestOption) (*twiliolo.Participant, error)
+	MuteContextCall         int
+	UnmuteContextFn         func(context.Context, string, string, []option.RequestOption) (*twiliolo.Participant, error)
+	UnmuteContextCall       int
+	HoldContextFn           func(context.Context, string, string, string, []option.RequestOption) (*twiliolo.Participant, error)
+	HoldContextCall         int
+	UnholdContextFn         func(context.Context, string, string, []option.RequestOption) (*twiliolo.Participant, error)
+	UnholdContextCall       int
+	CoachContextFn          func(context.Context, string, string, string, []option.RequestOption) (*twiliolo.Participant, error)
+	CoachContextCall        int
+	KickContextFn           func(context.Context, string, string, []option.RequestOption) error
+	KickContextCall         int
+	ListContextFn           func(context.Context, string, []option.RequestOption) (*twiliolo.ParticipantList, error)
+	ListContextCall         int
+	ListNextPageContextFn   func(context.Context, *twiliolo.ParticipantList) (*twiliolo.ParticipantList, error)
+	ListNextPageContextCall int
+	IterContextFn           func(context.Context, string, []option.RequestOption) *twiliolo.Iterator[*twiliolo.Participant]
+	IterContextCall         int
+}
+
+// Create mocked function.
+func (s *ParticipantService) Create(conferenceSid string, params *twiliolo.ParticipantParams, requestOptions ...option.RequestOption) (*twiliolo.Participant, error) {
+	s.CreateCall++
+
+	return s.CreateFn(conferenceSid, params, requestOptions)
+}
+
+// Get mocked function.
+func (s *ParticipantService) Get(conferenceSid string, callSid string, requestOptions ...option.RequestOption) (*twiliolo.Participant, error) {
+	s.GetCall++
+
+	return s.GetFn(conferenceSid, callSid, requestOptions)
+}
+
+// Update mocked function.
+func (s *ParticipantService) Update(conferenceSid string, callSid string, update *twiliolo.ParticipantUpdate, requestOptions ...option.RequestOption) (*twiliolo.Participant, error) {
+	s.UpdateCall++
+
+	return s.UpdateFn(conferenceSid, callSid, update, requestOptions)
+}
+
+// Mute mocked function.
+func (s *ParticipantService) Mute(conferenceSid string, callSid string, requestOptions ...option.RequestOption) (*twiliolo.Participant, error) {
+	s.MuteCall++
+
+	return s.MuteFn(conferenceSid, callSid, requestOptions)
+}
+
+// Unmute mocked function.
+func (s *ParticipantService) Unmute(conferenceSid string, callSid string, requestOptions ...option.RequestOption) (*twiliolo.Participant, error) {
+	s.UnmuteCall++
+
+	return s.UnmuteFn(conferenceSid, callSid, requestOptions)
+}
+
+// Hold mocked function.
+func (s *ParticipantService) Hold(conferenceSid string, callSid string, holdURL string, requestOptions ...option.RequestOption) (*twiliolo.Participant, error) {
+	s.HoldCall++
+
+	return s.HoldFn(conferenceSid, callSid, holdURL, requestOptions)
+}
+
+// Unhold mocked function.
+func (s *ParticipantService) Unhold(conferenceSid string, callSid string, requestOptions ...option.RequestOption) (*twiliolo.Participant, error) {
+	s.UnholdCall++
+
+	return s.UnholdFn(conferenceSid, callSid, requestOptions)
+}
+
+// Coach mocked function.
+func (s *ParticipantService) Coach(conferenceSid string, callSid string, callSidToCoach string, requestOptions ...option.RequestOption) (*twiliolo.Participant, error) {
+	s.CoachCall++
+
+	return s.CoachFn(conferenceSid, callSid, callSidToCoach, requestOptions)
+}
+
+// Kick mocked function.
+func (s *ParticipantService) Kick(conferenceSid string, callSid string, requestOptions ...option.RequestOption) error {
+	s.KickCall++
+
+	return s.KickFn(conferenceSid, callSid, requestOptions)
+}
+
+// List mocked function.
+func (s *ParticipantService) List(conferenceSid string, requestOptions ...option.RequestOption) (*twiliolo.ParticipantList, error) {
+	s.ListCall++
+
+	return s.ListFn(conferenceSid, requestOptions)
+}
+
+// ListNextPage mocked function.
+func (s *ParticipantService) ListNextPage(previousList *twiliolo.ParticipantList) (*twiliolo.ParticipantList, error) {
+	s.ListNextPageCall++
+
+	return s.ListNextPageFn(previousList)
+}
+
+// Iter mocked function.
+func (s *ParticipantService) Iter(conferenceSid string, requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Participant] {
+	s.IterCall++
+
+	return s.IterFn(conferenceSid, requestOptions)
+}
+
+// CreateContext mocked function.
+func (s *ParticipantService) CreateContext(ctx context.Context, conferenceSid string, params *twiliolo.ParticipantParams, requestOptions ...option.RequestOption) (*twiliolo.Participant, error) {
+	s.CreateContextCall++
+
+	return s.CreateContextFn(ctx, conferenceSid, params, requestOptions)
+}
+
+// GetContext mocked function.
+func (s *ParticipantService) GetContext(ctx context.Context, conferenceSid string, callSid string, requestOptions ...option.RequestOption) (*twiliolo.Participant, error) {
+	s.GetContextCall++
+
+	return s.GetContextFn(ctx, conferenceSid, callSid, requestOptions)
+}
+
+// UpdateContext mocked function.
+func (s *ParticipantService) UpdateContext(ctx context.Context, conferenceSid string, callSid string, update *twiliolo.ParticipantUpdate, requestOptions ...option.RequestOption) (*twiliolo.Participant, error) {
+	s.UpdateContextCall++
+
+	return s.UpdateContextFn(ctx, conferenceSid, callSid, update, requestOptions)
+}
+
+// MuteContext mocked function.
+func (s *ParticipantService) MuteContext(ctx context.Context, conferenceSid string, callSid string, requestOptions ...option.RequestOption) (*twiliolo.Participant, error) {
+	s.MuteContextCall++
+
+	return s.MuteContextFn(ctx, conferenceSid, callSid, requestOptions)
+}
+
+// UnmuteContext mocked function.
+func (s *ParticipantService) UnmuteContext(ctx context.Context, conferenceSid string, callSid string, requestOptions ...option.RequestOption) (*twiliolo.Participant, error) {
+	s.UnmuteContextCall++
+
+	return s.UnmuteContextFn(ctx, conferenceSid, callSid, requestOptions)
+}
+
+// HoldContext mocked function.
+func (s *ParticipantService) HoldContext(ctx context.Context, conferenceSid string, callSid string, holdURL string, requestOptions ...option.RequestOption) (*twiliolo.Participant, error) {
+	s.HoldContextCall++
+
+	return s.HoldContextFn(ctx, conferenceSid, callSid, holdURL, requestOptions)
+}
+
+// UnholdContext mocked function.
+func (s *ParticipantService) UnholdContext(ctx context.Context, conferenceSid string, callSid string, requestOptions ...option.RequestOption) (*twiliolo.Participant, error) {
+	s.UnholdContextCall++
+
+	return s.UnholdContextFn(ctx, conferenceSid, callSid, requestOptions)
+}
+
+// CoachContext mocked function.
+func (s *ParticipantService) CoachContext(ctx context.Context, conferenceSid string, callSid string, callSidToCoach string, requestOptions ...option.RequestOption) (*twiliolo.Participant, error) {
+	s.CoachContextCall++
+
+	return s.CoachContextFn(ctx, conferenceSid, callSid, callSidToCoach, requestOptions)
+}
+
+// KickContext mocked function.
+func (s *ParticipantService) KickContext(ctx context.Context, conferenceSid string, callSid string, requestOptions ...option.RequestOption) error {
+	s.KickContextCall++
+
+	return s.KickContextFn(ctx, conferenceSid, callSid, requestOptions)
+}
+
+// ListContext mocked function.
+func (s *ParticipantService) ListContext(ctx context.Context, conferenceSid string, requestOptions ...option.RequestOption) (*twiliolo.ParticipantList, error) {
+	s.ListContextCall++
+
+	return s.ListContextFn(ctx, conferenceSid, requestOptions)
+}
+
+// ListNextPageContext mocked function.
+func (s *ParticipantService) ListNextPageContext(ctx context.Context, previousList *twiliolo.ParticipantList) (*twiliolo.ParticipantList, error) {
+	s.ListNextPageContextCall++
+
+	return s.ListNextPageContextFn(ctx, previousList)
+}
+
+// IterContext mocked function.
+func (s *ParticipantService) IterContext(ctx context.Context, conferenceSid string, requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Participant] {
+	s.IterContextCall++
+
+	return s.IterContextFn(ctx, conferenceSid, requestOptions)
+}
@@ -0,0 +1,138 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/option"
+)
+
+// QueueService is the mock of a QueueService
+type QueueService struct {
+	CreateFn                func(string, int, []option.RequestOption) (*twiliolo.Queue, error)
+	CreateCall              int
+	GetFn                   func(string, []option.RequestOption) (*twiliolo.Queue, error)
+	GetCall                 int
+	UpdateFn                func(string, *twiliolo.QueueUpdate, []option.RequestOption) (*twiliolo.Queue, error)
+	UpdateCall              int
+	DeleteFn                func(string, []option.RequestOption) error
+	DeleteCall              int
+	ListFn                  func([]option.RequestOption) (*twiliolo.QueueList, error)
+	ListCall                int
+	ListNextPageFn          func(*twiliolo.QueueList) (*twiliolo.QueueList, error)
+	ListNextPageCall        int
+	IterFn                  func([]option.RequestOption) *twiliolo.Iterator[*twiliolo.Queue]
+	IterCall                int
+	CreateContextFn         func(context.Context, string, int, []option.RequestOption) (*twiliolo.Queue, error)
+	CreateContextCall       int
+	GetContextFn            func(context.Context, string, []option.RequestOption) (*twiliolo.Queue, error)
+	GetContextCall          int
+	UpdateContextFn         func(context.Context, string, *twiliolo.QueueUpdate, []option.RequestOption) (*twiliolo.Queue, error)
+	UpdateContextCall       int
+	DeleteContextFn         func(context.Context, string, []option.RequestOption) error
+	DeleteContextCall       int
+	ListContextFn           func(context.Context, []option.RequestOption) (*twiliolo.QueueList, error)
+	ListContextCall         int
+	ListNextPageContextFn   func(context.Context, *twiliolo.QueueList) (*twiliolo.QueueList, error)
+	ListNextPageContextCall int
+	IterContextFn           func(context.Context, []option.RequestOption) *twiliolo.Iterator[*twiliolo.Queue]
+	IterContextCall         int
+}
+
+// Create mocked function.
+func (s *QueueService) Create(friendlyName string, maxSize int, requestOptions ...option.RequestOption) (*twiliolo.Queue, error) {
+	s.CreateCall++
+
+	return s.CreateFn(friendlyName, maxSize, requestOptions)
+}
+
+// Get mocked function.
+func (s *QueueService) Get(sid string, requestOptions ...option.RequestOption) (*twiliolo.Queue, error) {
+	s.GetCall++
+
+	return s.GetFn(sid, requestOptions)
+}
+
+// Update mocked function.
+func (s *QueueService) Update(sid string, update *twiliolo.QueueUpdate, requestOptions ...option.RequestOption) (*twiliolo.Queue, error) {
+	s.UpdateCall++
+
+	return s.UpdateFn(sid, update, requestOptions)
+}
+
+// Delete mocked function.
+func (s *QueueService) Delete(sid string, requestOptions ...option.RequestOption) error {
+	s.DeleteCall++
+
+	return s.DeleteFn(sid, requestOptions)
+}
+
+// List mocked function.
+func (s *QueueService) List(requestOptions ...option.RequestOption) (*twiliolo.QueueList, error) {
+	s.ListCall++
+
+	return s.ListFn(requestOptions)
+}
+
+// ListNextPage mocked function.
+func (s *QueueService) ListNextPage(previousList *twiliolo.QueueList) (*twiliolo.QueueList, error) {
+	s.ListNextPageCall++
+
+	return s.ListNextPageFn(previousList)
+}
+
+// Iter mocked function.
+func (s *QueueService) Iter(requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Queue] {
+	s.IterCall++
+
+	return s.IterFn(requestOptions)
+}
+
+// CreateContext mocked function.
+func (s *QueueService) CreateContext(ctx context.Context, friendlyName string, maxSize int, requestOptions ...option.RequestOption) (*twiliolo.Queue, error) {
+	s.CreateContextCall++
+
+	return s.CreateContextFn(ctx, friendlyName, maxSize, requestOptions)
+}
+
+// GetContext mocked function.
+func (s *QueueService) GetContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*twiliolo.Queue, error) {
+	s.GetContextCall++
+
+	return s.GetContextFn(ctx, sid, requestOptions)
+}
+
+// UpdateContext mocked function.
+func (s *QueueService) UpdateContext(ctx context.Context, sid string, update *twiliolo.QueueUpdate, requestOptions ...option.RequestOption) (*twiliolo.Queue, error) {
+	s.UpdateContextCall++
+
+	return s.UpdateContextFn(ctx, sid, update, requestOptions)
+}
+
+// DeleteContext mocked function.
+func (s *QueueService) DeleteContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) error {
+	s.DeleteContextCall++
+
+	return s.DeleteContextFn(ctx, sid, requestOptions)
+}
+
+// ListContext mocked function.
+func (s *QueueService) ListContext(ctx context.Context, requestOptions ...option.RequestOption) (*twiliolo.QueueList, error) {
+	s.ListContextCall++
+
+	return s.ListContextFn(ctx, requestOptions)
+}
+
+// ListNextPageContext mocked function.
+func (s *QueueService) ListNextPageContext(ctx context.Context, previousList *twiliolo.QueueList) (*twiliolo.QueueList, error) {
+	s.ListNextPageContextCall++
+
+	return s.ListNextPageContextFn(ctx, previousList)
+}
+
+// IterContext mocked function.
+func (s *QueueService) IterContext(ctx context.Context, requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Queue] {
+	s.IterContextCall++
+
+	return s.IterContextFn(ctx, requestOptions)
+}
@@ -0,0 +1,138 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/option"
+)
+
+// QueueMemberService is the mock of a QueueMemberService
+type QueueMemberService struct {
+	GetFn                   func(string, string, []option.RequestOption) (*twiliolo.QueueMember, error)
+	GetCall                 int
+	FrontFn                 func(string, []option.RequestOption) (*twiliolo.QueueMember, error)
+	FrontCall               int
+	DequeueFn               func(string, string, string, []option.RequestOption) (*twiliolo.QueueMember, error)
+	DequeueCall             int
+	DequeueFrontFn          func(string, string, []option.RequestOption) (*twiliolo.QueueMember, error)
+	DequeueFrontCall        int
+	ListFn                  func(string, []option.RequestOption) (*twiliolo.QueueMemberList, error)
+	ListCall                int
+	ListNextPageFn          func(*twiliolo.QueueMemberList) (*twiliolo.QueueMemberList, error)
+	ListNextPageCall        int
+	IterFn                  func(string, []option.RequestOption) *twiliolo.Iterator[*twiliolo.QueueMember]
+	IterCall                int
+	GetContextFn            func(context.Context, string, string, []option.RequestOption) (*twiliolo.QueueMember, error)
+	GetContextCall          int
+	FrontContextFn          func(context.Context, string, []option.RequestOption) (*twiliolo.QueueMember, error)
+	FrontContextCall        int
+	DequeueContextFn        func(context.Context, string, string, string, []option.RequestOption) (*twiliolo.QueueMember, error)
+	DequeueContextCall      int
+	DequeueFrontContextFn   func(context.Context, string, string, []option.RequestOption) (*twiliolo.QueueMember, error)
+	DequeueFrontContextCall int
+	ListContextFn           func(context.Context, string, []option.RequestOption) (*twiliolo.QueueMemberList, error)
+	ListContextCall         int
+	ListNextPageContextFn   func(context.Context, *twiliolo.QueueMemberList) (*twiliolo.QueueMemberList, error)
+	ListNextPageContextCall int
+	IterContextFn           func(context.Context, string, []option.RequestOption) *twiliolo.Iterator[*twiliolo.QueueMember]
+	IterContextCall         int
+}
+
+// Get mocked function.
+func (s *QueueMemberService) Get(queueSid string, callSid string, requestOptions ...option.RequestOption) (*twiliolo.QueueMember, error) {
+	s.GetCall++
+
+	return s.GetFn(queueSid, callSid, requestOptions)
+}
+
+// Front mocked function.
+func (s *QueueMemberService) Front(queueSid string, requestOptions ...option.RequestOption) (*twiliolo.QueueMember, error) {
+	s.FrontCall++
+
+	return s.FrontFn(queueSid, requestOptions)
+}
+
+// Dequeue mocked function.
+func (s *QueueMemberService) Dequeue(queueSid string, callSid string, twimlURL string, requestOptions ...option.RequestOption) (*twiliolo.QueueMember, error) {
+	s.DequeueCall++
+
+	return s.DequeueFn(queueSid, callSid, twimlURL, requestOptions)
+}
+
+// DequeueFront mocked function.
+func (s *QueueMemberService) DequeueFront(queueSid string, twimlURL string, requestOptions ...option.RequestOption) (*twiliolo.QueueMember, error) {
+	s.DequeueFrontCall++
+
+	return s.DequeueFrontFn(queueSid, twimlURL, requestOptions)
+}
+
+// List mocked function.
+func (s *QueueMemberService) List(queueSid string, requestOptions ...option.RequestOption) (*twiliolo.QueueMemberList, error) {
+	s.ListCall++
+
+	return s.ListFn(queueSid, requestOptions)
+}
+
+// ListNextPage mocked function.
+func (s *QueueMemberService) ListNextPage(previousList *twiliolo.QueueMemberList) (*twiliolo.QueueMemberList, error) {
+	s.ListNextPageCall++
+
+	return s.ListNextPageFn(previousList)
+}
+
+// Iter mocked function.
+func (s *QueueMemberService) Iter(queueSid string, requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.QueueMember] {
+	s.IterCall++
+
+	return s.IterFn(queueSid, requestOptions)
+}
+
+// GetContext mocked function.
+func (s *QueueMemberService) GetContext(ctx context.Context, queueSid string, callSid string, requestOptions ...option.RequestOption) (*twiliolo.QueueMember, error) {
+	s.GetContextCall++
+
+	return s.GetContextFn(ctx, queueSid, callSid, requestOptions)
+}
+
+// FrontContext mocked function.
+func (s *QueueMemberService) FrontContext(ctx context.Context, queueSid string, requestOptions ...option.RequestOption) (*twiliolo.QueueMember, error) {
+	s.FrontContextCall++
+
+	return s.FrontContextFn(ctx, queueSid, requestOptions)
+}
+
+// DequeueContext mocked function.
+func (s *QueueMemberService) DequeueContext(ctx context.Context, queueSid string, callSid string, twimlURL string, requestOptions ...option.RequestOption) (*twiliolo.QueueMember, error) {
+	s.DequeueContextCall++
+
+	return s.DequeueContextFn(ctx, queueSid, callSid, twimlURL, requestOptions)
+}
+
+// DequeueFrontContext mocked function.
+func (s *QueueMemberService) DequeueFrontContext(ctx context.Context, queueSid string, twimlURL string, requestOptions ...option.RequestOption) (*twiliolo.QueueMember, error) {
+	s.DequeueFrontContextCall++
+
+	return s.DequeueFrontContextFn(ctx, queueSid, twimlURL, requestOptions)
+}
+
+// ListContext mocked function.
+func (s *QueueMemberService) ListContext(ctx context.Context, queueSid string, requestOptions ...option.RequestOption) (*twiliolo.QueueMemberList, error) {
+	s.ListContextCall++
+
+	return s.ListContextFn(ctx, queueSid, requestOptions)
+}
+
+// ListNextPageContext mocked function.
+func (s *QueueMemberService) ListNextPageContext(ctx context.Context, previousList *twiliolo.QueueMemberList) (*twiliolo.QueueMemberList, error) {
+	s.ListNextPageContextCall++
+
+	return s.ListNextPageContextFn(ctx, previousList)
+}
+
+// IterContext mocked function.
+func (s *QueueMemberService) IterContext(ctx context.Context, queueSid string, requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.QueueMember] {
+	s.IterContextCall++
+
+	return s.IterContextFn(ctx, queueSid, requestOptions)
+}
@@ -0,0 +1,193 @@
+package mock
+
+import (
+	"context"
+	"io"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/option"
+)
+
+// RecordingService is the mock of a RecordingService
+type RecordingService struct {
+	GetFn                       func(string, []option.RequestOption) (*twiliolo.Recording, error)
+	GetCall                     int
+	DeleteFn                    func(string, []option.RequestOption) error
+	DeleteCall                  int
+	DownloadFn                  func(string, twiliolo.RecordingFormat, io.Writer, []option.RequestOption) error
+	DownloadCall                int
+	ListFn                      func([]option.RequestOption) (*twiliolo.RecordingList, error)
+	ListCall                    int
+	ListByCallFn                func(string, []option.RequestOption) (*twiliolo.RecordingList, error)
+	ListByCallCall              int
+	ListByConferenceFn          func(string, []option.RequestOption) (*twiliolo.RecordingList, error)
+	ListByConferenceCall        int
+	ListNextPageFn              func(*twiliolo.RecordingList) (*twiliolo.RecordingList, error)
+	ListNextPageCall            int
+	IterFn                      func([]option.RequestOption) *twiliolo.Iterator[*twiliolo.Recording]
+	IterCall                    int
+	IterByCallFn                func(string, []option.RequestOption) *twiliolo.Iterator[*twiliolo.Recording]
+	IterByCallCall              int
+	IterByConferenceFn          func(string, []option.RequestOption) *twiliolo.Iterator[*twiliolo.Recording]
+	IterByConferenceCall        int
+	GetContextFn                func(context.Context, string, []option.RequestOption) (*twiliolo.Recording, error)
+	GetContextCall              int
+	DeleteContextFn             func(context.Context, string, []option.RequestOption) error
+	DeleteContextCall           int
+	DownloadContextFn           func(context.Context, string, twiliolo.RecordingFormat, io.Writer, []option.RequestOption) error
+	DownloadContextCall         int
+	ListContextFn               func(context.Context, []option.RequestOption) (*twiliolo.RecordingList, error)
+	ListContextCall             int
+	ListByCallContextFn         func(context.Context, string, []option.RequestOption) (*twiliolo.RecordingList, error)
+	ListByCallContextCall       int
+	ListByConferenceContextFn   func(context.Context, string, []option.RequestOption) (*twiliolo.RecordingList, error)
+	ListByConferenceContextCall int
+	ListNextPageContextFn       func(context.Context, *twiliolo.RecordingList) (*twiliolo.RecordingList, error)
+	ListNextPageContextCall     int
+	IterContextFn               func(context.Context, []option.RequestOption) *twiliolo.Iterator[*twiliolo.Recording]
+	IterContextCall             int
+	IterByCallContextFn         func(context.Context, string, []option.RequestOption) *twiliolo.Iterator[*twiliolo.Recording]
+	IterByCallContextCall       int
+	IterByConferenceContextFn   func(context.Context, string, []option.RequestOption) *twiliolo.Iterator[*twiliolo.Recording]
+	IterByConferenceContextCall int
+}
+
+// Get mocked function.
+func (s *RecordingService) Get(sid string, requestOptions ...option.RequestOption) (*twiliolo.Recording, error) {
+	s.GetCall++
+
+	return s.GetFn(sid, requestOptions)
+}
+
+// Delete mocked function.
+func (s *RecordingService) Delete(sid string, requestOptions ...option.RequestOption) error {
+	s.DeleteCall++
+
+	return s.DeleteFn(sid, requestOptions)
+}
+
+// Download mocked function.
+func (s *RecordingService) Download(sid string, format twiliolo.RecordingFormat, w io.Writer, requestOptions ...option.RequestOption) error {
+	s.DownloadCall++
+
+	return s.DownloadFn(sid, format, w, requestOptions)
+}
+
+// List mocked function.
+func (s *RecordingService) List(requestOptions ...option.RequestOption) (*twiliolo.RecordingList, error) {
+	s.ListCall++
+
+	return s.ListFn(requestOptions)
+}
+
+// ListByCall mocked function.
+func (s *RecordingService) ListByCall(callSid string, requestOptions ...option.RequestOption) (*twiliolo.RecordingList, error) {
+	s.ListByCallCall++
+
+	return s.ListByCallFn(callSid, requestOptions)
+}
+
+// ListByConference mocked function.
+func (s *RecordingService) ListByConference(conferenceSid string, requestOptions ...option.RequestOption) (*twiliolo.RecordingList, error) {
+	s.ListByConferenceCall++
+
+	return s.ListByConferenceFn(conferenceSid, requestOptions)
+}
+
+// ListNextPage mocked function.
+func (s *RecordingService) ListNextPage(previousList *twiliolo.RecordingList) (*twiliolo.RecordingList, error) {
+	s.ListNextPageCall++
+
+	return s.ListNextPageFn(previousList)
+}
+
+// Iter mocked function.
+func (s *RecordingService) Iter(requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Recording] {
+	s.IterCall++
+
+	return s.IterFn(requestOptions)
+}
+
+// IterByCall mocked function.
+func (s *RecordingService) IterByCall(callSid string, requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Recording] {
+	s.IterByCallCall++
+
+	return s.IterByCallFn(callSid, requestOptions)
+}
+
+// IterByConference mocked function.
+func (s *RecordingService) IterByConference(conferenceSid string, requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Recording] {
+	s.IterByConferenceCall++
+
+	return s.IterByConferenceFn(conferenceSid, requestOptions)
+}
+
+// GetContext mocked function.
+func (s *RecordingService) GetContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*twiliolo.Recording, error) {
+	s.GetContextCall++
+
+	return s.GetContextFn(ctx, sid, requestOptions)
+}
+
+// DeleteContext mocked function.
+func (s *RecordingService) DeleteContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) error {
+	s.DeleteContextCall++
+
+	return s.DeleteContextFn(ctx, sid, requestOptions)
+}
+
+// DownloadContext mocked function.
+func (s *RecordingService) DownloadContext(ctx context.Context, sid string, format twiliolo.RecordingFormat, w io.Writer, requestOptions ...option.RequestOption) error {
+	s.DownloadContextCall++
+
+	return s.DownloadContextFn(ctx, sid, format, w, requestOptions)
+}
+
+// ListContext mocked function.
+func (s *RecordingService) ListContext(ctx context.Context, requestOptions ...option.RequestOption) (*twiliolo.RecordingList, error) {
+	s.ListContextCall++
+
+	return s.ListContextFn(ctx, requestOptions)
+}
+
+// ListByCallContext mocked function.
+func (s *RecordingService) ListByCallContext(ctx context.Context, callSid string, requestOptions ...option.RequestOption) (*twiliolo.RecordingList, error) {
+	s.ListByCallContextCall++
+
+	return s.ListByCallContextFn(ctx, callSid, requestOptions)
+}
+
+// ListByConferenceContext mocked function.
+func (s *RecordingService) ListByConferenceContext(ctx context.Context, conferenceSid string, requestOptions ...option.RequestOption) (*twiliolo.RecordingList, error) {
+	s.ListByConferenceContextCall++
+
+	return s.ListByConferenceContextFn(ctx, conferenceSid, requestOptions)
+}
+
+// ListNextPageContext mocked function.
+func (s *RecordingService) ListNextPageContext(ctx context.Context, previousList *twiliolo.RecordingList) (*twiliolo.RecordingList, error) {
+	s.ListNextPageContextCall++
+
+	return s.ListNextPageContextFn(ctx, previousList)
+}
+
+// IterContext mocked function.
+func (s *RecordingService) IterContext(ctx context.Context, requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Recording] {
+	s.IterContextCall++
+
+	return s.IterContextFn(ctx, requestOptions)
+}
+
+// IterByCallContext mocked function.
+func (s *RecordingService) IterByCallContext(ctx context.Context, callSid string, requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Recording] {
+	s.IterByCallContextCall++
+
+	return s.IterByCallContextFn(ctx, callSid, requestOptions)
+}
+
+// IterByConferenceContext mocked function.
+func (s *RecordingService) IterByConferenceContext(ctx context.Context, conferenceSid string, requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Recording] {
+	s.IterByConferenceContextCall++
+
+	return s.IterByConferenceContextFn(ctx, conferenceSid, requestOptions)
+}
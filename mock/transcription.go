@@ -0,0 +1,138 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/option"
+)
+
+// TranscriptionService is the mock of a TranscriptionService
+type TranscriptionService struct {
+	GetFn                      func(string, []option.RequestOption) (*twiliolo.Transcription, error)
+	GetCall                    int
+	DeleteFn                   func(string, []option.RequestOption) error
+	DeleteCall                 int
+	ListFn                     func([]option.RequestOption) (*twiliolo.TranscriptionList, error)
+	ListCall                   int
+	ListByRecordingFn          func(string, []option.RequestOption) (*twiliolo.TranscriptionList, error)
+	ListByRecordingCall        int
+	ListNextPageFn             func(*twiliolo.TranscriptionList) (*twiliolo.TranscriptionList, error)
+	ListNextPageCall           int
+	IterFn                     func([]option.RequestOption) *twiliolo.Iterator[*twiliolo.Transcription]
+	IterCall                   int
+	IterByRecordingFn          func(string, []option.RequestOption) *twiliolo.Iterator[*twiliolo.Transcription]
+	IterByRecordingCall        int
+	GetContextFn               func(context.Context, string, []option.RequestOption) (*twiliolo.Transcription, error)
+	GetContextCall             int
+	DeleteContextFn            func(context.Context, string, []option.RequestOption) error
+	DeleteContextCall          int
+	ListContextFn              func(context.Context, []option.RequestOption) (*twiliolo.TranscriptionList, error)
+	ListContextCall            int
+	ListByRecordingContextFn   func(context.Context, string, []option.RequestOption) (*twiliolo.TranscriptionList, error)
+	ListByRecordingContextCall int
+	ListNextPageContextFn      func(context.Context, *twiliolo.TranscriptionList) (*twiliolo.TranscriptionList, error)
+	ListNextPageContextCall    int
+	IterContextFn              func(context.Context, []option.RequestOption) *twiliolo.Iterator[*twiliolo.Transcription]
+	IterContextCall            int
+	IterByRecordingContextFn   func(context.Context, string, []option.RequestOption) *twiliolo.Iterator[*twiliolo.Transcription]
+	IterByRecordingContextCall int
+}
+
+// Get mocked function.
+func (s *TranscriptionService) Get(sid string, requestOptions ...option.RequestOption) (*twiliolo.Transcription, error) {
+	s.GetCall++
+
+	return s.GetFn(sid, requestOptions)
+}
+
+// Delete mocked function.
+func (s *TranscriptionService) Delete(sid string, requestOptions ...option.RequestOption) error {
+	s.DeleteCall++
+
+	return s.DeleteFn(sid, requestOptions)
+}
+
+// List mocked function.
+func (s *TranscriptionService) List(requestOptions ...option.RequestOption) (*twiliolo.TranscriptionList, error) {
+	s.ListCall++
+
+	return s.ListFn(requestOptions)
+}
+
+// ListByRecording mocked function.
+func (s *TranscriptionService) ListByRecording(recordingSid string, requestOptions ...option.RequestOption) (*twiliolo.TranscriptionList, error) {
+	s.ListByRecordingCall++
+
+	return s.ListByRecordingFn(recordingSid, requestOptions)
+}
+
+// ListNextPage mocked function.
+func (s *TranscriptionService) ListNextPage(previousList *twiliolo.TranscriptionList) (*twiliolo.TranscriptionList, error) {
+	s.ListNextPageCall++
+
+	return s.ListNextPageFn(previousList)
+}
+
+// Iter mocked function.
+func (s *TranscriptionService) Iter(requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Transcription] {
+	s.IterCall++
+
+	return s.IterFn(requestOptions)
+}
+
+// IterByRecording mocked function.
+func (s *TranscriptionService) IterByRecording(recordingSid string, requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Transcription] {
+	s.IterByRecordingCall++
+
+	return s.IterByRecordingFn(recordingSid, requestOptions)
+}
+
+// GetContext mocked function.
+func (s *TranscriptionService) GetContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*twiliolo.Transcription, error) {
+	s.GetContextCall++
+
+	return s.GetContextFn(ctx, sid, requestOptions)
+}
+
+// DeleteContext mocked function.
+func (s *TranscriptionService) DeleteContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) error {
+	s.DeleteContextCall++
+
+	return s.DeleteContextFn(ctx, sid, requestOptions)
+}
+
+// ListContext mocked function.
+func (s *TranscriptionService) ListContext(ctx context.Context, requestOptions ...option.RequestOption) (*twiliolo.TranscriptionList, error) {
+	s.ListContextCall++
+
+	return s.ListContextFn(ctx, requestOptions)
+}
+
+// ListByRecordingContext mocked function.
+func (s *TranscriptionService) ListByRecordingContext(ctx context.Context, recordingSid string, requestOptions ...option.RequestOption) (*twiliolo.TranscriptionList, error) {
+	s.ListByRecordingContextCall++
+
+	return s.ListByRecordingContextFn(ctx, recordingSid, requestOptions)
+}
+
+// ListNextPageContext mocked function.
+func (s *TranscriptionService) ListNextPageContext(ctx context.Context, previousList *twiliolo.TranscriptionList) (*twiliolo.TranscriptionList, error) {
+	s.ListNextPageContextCall++
+
+	return s.ListNextPageContextFn(ctx, previousList)
+}
+
+// IterContext mocked function.
+func (s *TranscriptionService) IterContext(ctx context.Context, requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Transcription] {
+	s.IterContextCall++
+
+	return s.IterContextFn(ctx, requestOptions)
+}
+
+// IterByRecordingContext mocked function.
+func (s *TranscriptionService) IterByRecordingContext(ctx context.Context, recordingSid string, requestOptions ...option.RequestOption) *twiliolo.Iterator[*twiliolo.Transcription] {
+	s.IterByRecordingContextCall++
+
+	return s.IterByRecordingContextFn(ctx, recordingSid, requestOptions)
+}
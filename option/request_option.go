@@ -1,6 +1,12 @@
 package option
 
-import "strconv"
+import (
+	"strconv"
+	"time"
+)
+
+// dateFormat is the format of the dates used as filters by Twilio
+const dateFormat = "2006-01-02"
 
 // RequestOption is the interface implemented by each querystring parameter used by Twilio API
 type RequestOption interface {
@@ -39,7 +45,8 @@ func (o AreaCode) GetValue() (string, string) {
 	return "AreaCode", string(o)
 }
 
-// Contains type for querystring parameter
+// Contains type for querystring parameter, a pattern of digits and letters
+// where "*" matches any single digit, e.g. "510555****" or "STORM".
 type Contains string
 
 // GetValue returns the query string compliant name and value
@@ -92,7 +99,7 @@ type ExcludeForeignAddressRequired bool
 
 // GetValue returns the query string compliant name and value
 func (o ExcludeForeignAddressRequired) GetValue() (string, string) {
-	return "ExcludeForeignAddressRequired	", strconv.FormatBool(bool(o))
+	return "ExcludeForeignAddressRequired", strconv.FormatBool(bool(o))
 }
 
 // ExcludeLocalAddressRequired type for querystring parameter
@@ -102,3 +109,222 @@ type ExcludeLocalAddressRequired bool
 func (o ExcludeLocalAddressRequired) GetValue() (string, string) {
 	return "ExcludeLocalAddressRequired", strconv.FormatBool(bool(o))
 }
+
+// NearNumber type for querystring parameter
+type NearNumber string
+
+// GetValue returns the query string compliant name and value
+func (o NearNumber) GetValue() (string, string) {
+	return "NearNumber", string(o)
+}
+
+// NearLatLong type for querystring parameter
+type NearLatLong struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// GetValue returns the query string compliant name and value
+func (o NearLatLong) GetValue() (string, string) {
+	return "NearLatLong", strconv.FormatFloat(o.Latitude, 'f', -1, 64) + "," + strconv.FormatFloat(o.Longitude, 'f', -1, 64)
+}
+
+// Distance type for querystring parameter, in miles
+type Distance int
+
+// GetValue returns the query string compliant name and value
+func (o Distance) GetValue() (string, string) {
+	return "Distance", strconv.Itoa(int(o))
+}
+
+// InPostalCode type for querystring parameter
+type InPostalCode string
+
+// GetValue returns the query string compliant name and value
+func (o InPostalCode) GetValue() (string, string) {
+	return "InPostalCode", string(o)
+}
+
+// InRegion type for querystring parameter
+type InRegion string
+
+// GetValue returns the query string compliant name and value
+func (o InRegion) GetValue() (string, string) {
+	return "InRegion", string(o)
+}
+
+// InRateCenter type for querystring parameter
+type InRateCenter string
+
+// GetValue returns the query string compliant name and value
+func (o InRateCenter) GetValue() (string, string) {
+	return "InRateCenter", string(o)
+}
+
+// InLata type for querystring parameter
+type InLata string
+
+// GetValue returns the query string compliant name and value
+func (o InLata) GetValue() (string, string) {
+	return "InLata", string(o)
+}
+
+// InLocality type for querystring parameter
+type InLocality string
+
+// GetValue returns the query string compliant name and value
+func (o InLocality) GetValue() (string, string) {
+	return "InLocality", string(o)
+}
+
+// To type for querystring parameter
+type To string
+
+// GetValue returns the query string compliant name and value
+func (o To) GetValue() (string, string) {
+	return "To", string(o)
+}
+
+// From type for querystring parameter
+type From string
+
+// GetValue returns the query string compliant name and value
+func (o From) GetValue() (string, string) {
+	return "From", string(o)
+}
+
+// DateSent type for querystring parameter
+type DateSent time.Time
+
+// GetValue returns the query string compliant name and value
+func (o DateSent) GetValue() (string, string) {
+	return "DateSent", time.Time(o).Format(dateFormat)
+}
+
+// DateSentBefore type for querystring parameter
+type DateSentBefore time.Time
+
+// GetValue returns the query string compliant name and value
+func (o DateSentBefore) GetValue() (string, string) {
+	return "DateSent<", time.Time(o).Format(dateFormat)
+}
+
+// DateSentAfter type for querystring parameter
+type DateSentAfter time.Time
+
+// GetValue returns the query string compliant name and value
+func (o DateSentAfter) GetValue() (string, string) {
+	return "DateSent>", time.Time(o).Format(dateFormat)
+}
+
+// Status type for querystring parameter
+type Status string
+
+// GetValue returns the query string compliant name and value
+func (o Status) GetValue() (string, string) {
+	return "Status", string(o)
+}
+
+// ParentCallSid type for querystring parameter
+type ParentCallSid string
+
+// GetValue returns the query string compliant name and value
+func (o ParentCallSid) GetValue() (string, string) {
+	return "ParentCallSid", string(o)
+}
+
+// StartTime type for querystring parameter
+type StartTime time.Time
+
+// GetValue returns the query string compliant name and value
+func (o StartTime) GetValue() (string, string) {
+	return "StartTime", time.Time(o).Format(dateFormat)
+}
+
+// StartTimeBefore type for querystring parameter
+type StartTimeBefore time.Time
+
+// GetValue returns the query string compliant name and value
+func (o StartTimeBefore) GetValue() (string, string) {
+	return "StartTime<", time.Time(o).Format(dateFormat)
+}
+
+// StartTimeAfter type for querystring parameter
+type StartTimeAfter time.Time
+
+// GetValue returns the query string compliant name and value
+func (o StartTimeAfter) GetValue() (string, string) {
+	return "StartTime>", time.Time(o).Format(dateFormat)
+}
+
+// FriendlyName type for querystring parameter
+type FriendlyName string
+
+// GetValue returns the query string compliant name and value
+func (o FriendlyName) GetValue() (string, string) {
+	return "FriendlyName", string(o)
+}
+
+// PhoneNumber type for querystring parameter, matching all or part of a phone number
+type PhoneNumber string
+
+// GetValue returns the query string compliant name and value
+func (o PhoneNumber) GetValue() (string, string) {
+	return "PhoneNumber", string(o)
+}
+
+// Origin type for querystring parameter, either "twilio" or "hosted"
+type Origin string
+
+// GetValue returns the query string compliant name and value
+func (o Origin) GetValue() (string, string) {
+	return "Origin", string(o)
+}
+
+// DateCreated type for querystring parameter
+type DateCreated time.Time
+
+// GetValue returns the query string compliant name and value
+func (o DateCreated) GetValue() (string, string) {
+	return "DateCreated", time.Time(o).Format(dateFormat)
+}
+
+// DateCreatedBefore type for querystring parameter
+type DateCreatedBefore time.Time
+
+// GetValue returns the query string compliant name and value
+func (o DateCreatedBefore) GetValue() (string, string) {
+	return "DateCreated<", time.Time(o).Format(dateFormat)
+}
+
+// DateCreatedAfter type for querystring parameter
+type DateCreatedAfter time.Time
+
+// GetValue returns the query string compliant name and value
+func (o DateCreatedAfter) GetValue() (string, string) {
+	return "DateCreated>", time.Time(o).Format(dateFormat)
+}
+
+// Muted type for querystring parameter
+type Muted bool
+
+// GetValue returns the query string compliant name and value
+func (o Muted) GetValue() (string, string) {
+	return "Muted", strconv.FormatBool(bool(o))
+}
+
+// Hold type for querystring parameter
+type Hold bool
+
+// GetValue returns the query string compliant name and value
+func (o Hold) GetValue() (string, string) {
+	return "Hold", strconv.FormatBool(bool(o))
+}
+
+// Coaching type for querystring parameter
+type Coaching bool
+
+// GetValue returns the query string compliant name and value
+func (o Coaching) GetValue() (string, string) {
+	return "Coaching", strconv.FormatBool(bool(o))
+}
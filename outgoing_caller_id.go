@@ -0,0 +1,228 @@
+package twiliolo
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// OutgoingCallerIDServiceInterface is the interface of an OutgoingCallerIDService
+type OutgoingCallerIDServiceInterface interface {
+	Validate(*ValidationRequestParams, ...option.RequestOption) (*ValidationRequest, error)
+	Get(string, ...option.RequestOption) (*OutgoingCallerID, error)
+	Rename(string, string, ...option.RequestOption) (*OutgoingCallerID, error)
+	Delete(string, ...option.RequestOption) error
+	List(...option.RequestOption) (*OutgoingCallerIDList, error)
+	ListNextPage(*OutgoingCallerIDList) (*OutgoingCallerIDList, error)
+	Iter(...option.RequestOption) *Iterator[*OutgoingCallerID]
+	ValidateContext(context.Context, *ValidationRequestParams, ...option.RequestOption) (*ValidationRequest, error)
+	GetContext(context.Context, string, ...option.RequestOption) (*OutgoingCallerID, error)
+	RenameContext(context.Context, string, string, ...option.RequestOption) (*OutgoingCallerID, error)
+	DeleteContext(context.Context, string, ...option.RequestOption) error
+	ListContext(context.Context, ...option.RequestOption) (*OutgoingCallerIDList, error)
+	ListNextPageContext(context.Context, *OutgoingCallerIDList) (*OutgoingCallerIDList, error)
+	IterContext(context.Context, ...option.RequestOption) *Iterator[*OutgoingCallerID]
+}
+
+// OutgoingCallerIDService handles communication with the Outgoing Caller ID related methods.
+type OutgoingCallerIDService service
+
+// OutgoingCallerID represents a verified phone number which can be used as caller ID.
+type OutgoingCallerID struct {
+	Sid          string    `json:"sid"`
+	AccountSid   string    `json:"account_sid"`
+	FriendlyName string    `json:"friendly_name"`
+	PhoneNumber  string    `json:"phone_number"`
+	DateCreated  time.Time `json:"date_created"`
+	DateUpdated  time.Time `json:"date_updated"`
+	URI          string    `json:"uri"`
+}
+
+// UnmarshalJSON decodes an OutgoingCallerID, parsing its RFC 2822 dates.
+func (o *OutgoingCallerID) UnmarshalJSON(data []byte) error {
+	type outgoingCallerID OutgoingCallerID
+
+	raw := struct {
+		*outgoingCallerID
+		DateCreated string `json:"date_created"`
+		DateUpdated string `json:"date_updated"`
+	}{outgoingCallerID: (*outgoingCallerID)(o)}
+
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	o.DateCreated, err = parseDate(raw.DateCreated)
+	if err != nil {
+		return err
+	}
+
+	o.DateUpdated, err = parseDate(raw.DateUpdated)
+
+	return err
+}
+
+// MarshalJSON encodes an OutgoingCallerID, formatting its dates in RFC 2822 like Twilio.
+func (o OutgoingCallerID) MarshalJSON() ([]byte, error) {
+	type outgoingCallerID OutgoingCallerID
+
+	return json.Marshal(struct {
+		outgoingCallerID
+		DateCreated string `json:"date_created"`
+		DateUpdated string `json:"date_updated"`
+	}{
+		outgoingCallerID: outgoingCallerID(o),
+		DateCreated:      formatDate(o.DateCreated),
+		DateUpdated:      formatDate(o.DateUpdated),
+	})
+}
+
+// ValidationRequest represents a pending verification of a phone number,
+// Twilio calls it and the ValidationCode must be entered on the keypad.
+type ValidationRequest struct {
+	AccountSid     string `json:"account_sid"`
+	CallSid        string `json:"call_sid"`
+	FriendlyName   string `json:"friendly_name"`
+	PhoneNumber    string `json:"phone_number"`
+	ValidationCode string `json:"validation_code"`
+}
+
+// ValidationRequestParams contains the parameters used to start the verification
+// of a phone number. PhoneNumber is required.
+type ValidationRequestParams struct {
+	PhoneNumber  string
+	FriendlyName string
+	// CallDelay is the number of seconds to wait before dialing, ignored when 0.
+	CallDelay            int
+	Extension            string
+	StatusCallback       string
+	StatusCallbackMethod string
+}
+
+func (p *ValidationRequestParams) values() url.Values {
+	values := url.Values{}
+
+	fields := []struct {
+		key   string
+		value string
+	}{
+		{"PhoneNumber", p.PhoneNumber},
+		{"FriendlyName", p.FriendlyName},
+		{"Extension", p.Extension},
+		{"StatusCallback", p.StatusCallback},
+		{"StatusCallbackMethod", p.StatusCallbackMethod},
+	}
+
+	for _, field := range fields {
+		if field.value != "" {
+			values.Set(field.key, field.value)
+		}
+	}
+
+	if p.CallDelay != 0 {
+		values.Set("CallDelay", strconv.Itoa(p.CallDelay))
+	}
+
+	return values
+}
+
+// Validate starts the verification of a phone number, the returned ValidationCode
+// must be entered when Twilio calls it.
+// Doc: https://www.twilio.com/docs/voice/api/outgoing-caller-ids#add-an-outgoing-caller-id
+func (s *OutgoingCallerIDService) Validate(params *ValidationRequestParams, requestOptions ...option.RequestOption) (*ValidationRequest, error) {
+	return s.ValidateContext(context.Background(), params, requestOptions...)
+}
+
+// ValidateContext performs the same call as Validate, bound to the given context.
+func (s *OutgoingCallerIDService) ValidateContext(ctx context.Context, params *ValidationRequestParams, requestOptions ...option.RequestOption) (*ValidationRequest, error) {
+	if params == nil || params.PhoneNumber == "" {
+		return nil, ErrOutgoingCallerIDMissingData
+	}
+
+	body, err := s.Client.PostContext(ctx, "/OutgoingCallerIds.json", requestOptions, params.values())
+	if err != nil {
+		return nil, err
+	}
+
+	var validationRequest ValidationRequest
+
+	err = json.Unmarshal(body, &validationRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return &validationRequest, nil
+}
+
+// Get performs a call to the twilio API to retrieve an Outgoing Caller ID with its Sid.
+// Doc: https://www.twilio.com/docs/voice/api/outgoing-caller-ids#fetch-an-outgoingcallerid-resource
+func (s *OutgoingCallerIDService) Get(sid string, requestOptions ...option.RequestOption) (*OutgoingCallerID, error) {
+	return s.GetContext(context.Background(), sid, requestOptions...)
+}
+
+// GetContext performs the same call as Get, bound to the given context.
+func (s *OutgoingCallerIDService) GetContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*OutgoingCallerID, error) {
+	if sid == "" {
+		return nil, ErrOutgoingCallerIDMissingData
+	}
+
+	res, err := s.Client.GetContext(ctx, "/OutgoingCallerIds/"+sid+".json", requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	callerID := new(OutgoingCallerID)
+	err = json.Unmarshal(res, callerID)
+
+	return callerID, err
+}
+
+// Rename updates the FriendlyName of an Outgoing Caller ID.
+// Doc: https://www.twilio.com/docs/voice/api/outgoing-caller-ids#update-an-outgoingcallerid-resource
+func (s *OutgoingCallerIDService) Rename(sid, friendlyName string, requestOptions ...option.RequestOption) (*OutgoingCallerID, error) {
+	return s.RenameContext(context.Background(), sid, friendlyName, requestOptions...)
+}
+
+// RenameContext performs the same call as Rename, bound to the given context.
+func (s *OutgoingCallerIDService) RenameContext(ctx context.Context, sid, friendlyName string, requestOptions ...option.RequestOption) (*OutgoingCallerID, error) {
+	if sid == "" {
+		return nil, ErrOutgoingCallerIDMissingData
+	}
+
+	values := url.Values{}
+	values.Set("FriendlyName", friendlyName)
+
+	body, err := s.Client.PostContext(ctx, "/OutgoingCallerIds/"+sid+".json", requestOptions, values)
+	if err != nil {
+		return nil, err
+	}
+
+	var callerID OutgoingCallerID
+
+	err = json.Unmarshal(body, &callerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &callerID, nil
+}
+
+// Delete removes an Outgoing Caller ID, the number can no longer be used as caller ID.
+// Doc: https://www.twilio.com/docs/voice/api/outgoing-caller-ids#delete-an-outgoingcallerid-resource
+func (s *OutgoingCallerIDService) Delete(sid string, requestOptions ...option.RequestOption) error {
+	return s.DeleteContext(context.Background(), sid, requestOptions...)
+}
+
+// DeleteContext performs the same call as Delete, bound to the given context.
+func (s *OutgoingCallerIDService) DeleteContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) error {
+	if sid == "" {
+		return ErrOutgoingCallerIDMissingData
+	}
+
+	return s.Client.DeleteContext(ctx, "/OutgoingCallerIds/"+sid+".json", requestOptions)
+}
@@ -0,0 +1,76 @@
+package twiliolo
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// OutgoingCallerIDList represents the response of the Twilio API when calling /OutgoingCallerIds.json
+type OutgoingCallerIDList struct {
+	Page              int                 `json:"page"`
+	PageSize          int                 `json:"page_size"`
+	URI               string              `json:"uri"`
+	FirstPageURI      string              `json:"first_page_uri"`
+	NextPageURI       string              `json:"next_page_uri"`
+	PreviousPageURI   string              `json:"previous_page_uri"`
+	OutgoingCallerIDs []*OutgoingCallerID `json:"outgoing_caller_ids"`
+}
+
+// List retrieves the first page of the verified Outgoing Caller IDs, filtered with the PhoneNumber and FriendlyName options.
+// Doc: https://www.twilio.com/docs/voice/api/outgoing-caller-ids#read-multiple-outgoingcallerid-resources
+func (s *OutgoingCallerIDService) List(requestOptions ...option.RequestOption) (*OutgoingCallerIDList, error) {
+	return s.ListContext(context.Background(), requestOptions...)
+}
+
+// ListContext performs the same call as List, bound to the given context.
+func (s *OutgoingCallerIDService) ListContext(ctx context.Context, requestOptions ...option.RequestOption) (*OutgoingCallerIDList, error) {
+	body, err := s.Client.GetContext(ctx, "/OutgoingCallerIds.json", requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	callerIDList := new(OutgoingCallerIDList)
+	err = json.Unmarshal(body, callerIDList)
+
+	return callerIDList, err
+}
+
+// ListNextPage retrieves the next page of a given OutgoingCallerIDList by following its NextPageURI.
+// If an empty NextPageURI is present in the struct it'll return an error
+func (s *OutgoingCallerIDService) ListNextPage(previousList *OutgoingCallerIDList) (*OutgoingCallerIDList, error) {
+	return s.ListNextPageContext(context.Background(), previousList)
+}
+
+// ListNextPageContext performs the same call as ListNextPage, bound to the given context.
+func (s *OutgoingCallerIDService) ListNextPageContext(ctx context.Context, previousList *OutgoingCallerIDList) (*OutgoingCallerIDList, error) {
+	if previousList == nil || previousList.NextPageURI == "" {
+		return nil, ErrNoNextPage
+	}
+
+	body, err := s.Client.GetContext(ctx, previousList.NextPageURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	callerIDList := new(OutgoingCallerIDList)
+	err = json.Unmarshal(body, callerIDList)
+
+	return callerIDList, err
+}
+
+// Iter returns an Iterator over all the verified Outgoing Caller IDs, filtered with the PhoneNumber and FriendlyName options.
+func (s *OutgoingCallerIDService) Iter(requestOptions ...option.RequestOption) *Iterator[*OutgoingCallerID] {
+	return s.IterContext(context.Background(), requestOptions...)
+}
+
+// IterContext performs the same calls as Iter, bound to the given context.
+func (s *OutgoingCallerIDService) IterContext(ctx context.Context, requestOptions ...option.RequestOption) *Iterator[*OutgoingCallerID] {
+	return newIterator(ctx, s.Client, "/OutgoingCallerIds.json", requestOptions, func(body []byte) ([]*OutgoingCallerID, string, error) {
+		list := new(OutgoingCallerIDList)
+		err := json.Unmarshal(body, list)
+
+		return list.OutgoingCallerIDs, list.NextPageURI, err
+	})
+}
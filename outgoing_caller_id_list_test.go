@@ -0,0 +1,59 @@
+package twiliolo_test
+
+import (
+	"testing"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutgoingCallerIDList(t *testing.T) {
+	client := new(internal.MockAPIClient)
+	client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+		assert.Equal(t, "/OutgoingCallerIds.json", uri)
+		assert.Equal(t, []option.RequestOption{option.PhoneNumber("+14158675310")}, requestOptions)
+
+		return []byte(`
+		{
+			"page": 0,
+			"page_size": 50,
+			"outgoing_caller_ids": [{"sid": "PNTwilioloFake", "phone_number": "+14158675310", "friendly_name": "Customer desk"}]
+		}`), nil
+	}
+
+	service := twiliolo.OutgoingCallerIDService{Client: client}
+	list, err := service.List(option.PhoneNumber("+14158675310"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(list.OutgoingCallerIDs))
+	assert.Equal(t, "Customer desk", list.OutgoingCallerIDs[0].FriendlyName)
+}
+
+func TestOutgoingCallerIDListNextPage(t *testing.T) {
+	t.Run("OK - Follow next page URI", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/2010-04-01/Accounts/TwilioloFake/OutgoingCallerIds.json?Page=1", uri)
+			assert.Empty(t, requestOptions)
+
+			return []byte(`{"page": 1, "outgoing_caller_ids": [{"sid": "PNTwilioloFake2"}]}`), nil
+		}
+
+		service := twiliolo.OutgoingCallerIDService{Client: client}
+		list, err := service.ListNextPage(&twiliolo.OutgoingCallerIDList{NextPageURI: "/2010-04-01/Accounts/TwilioloFake/OutgoingCallerIds.json?Page=1"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "PNTwilioloFake2", list.OutgoingCallerIDs[0].Sid)
+	})
+
+	t.Run("NOK - No next page", func(t *testing.T) {
+		service := twiliolo.OutgoingCallerIDService{Client: new(internal.MockAPIClient)}
+
+		list, err := service.ListNextPage(&twiliolo.OutgoingCallerIDList{})
+
+		assert.Equal(t, twiliolo.ErrNoNextPage, err)
+		assert.Nil(t, list)
+	})
+}
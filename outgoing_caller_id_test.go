@@ -0,0 +1,154 @@
+package twiliolo_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+const testOutgoingCallerIDResponse = `
+{
+	"sid": "PNTwilioloFake",
+	"account_sid": "TwilioloFake",
+	"friendly_name": "Customer desk",
+	"phone_number": "+14158675310",
+	"date_created": "Mon, 16 Aug 2010 03:45:01 +0000",
+	"date_updated": "Mon, 16 Aug 2010 03:45:01 +0000",
+	"uri": "\/2010-04-01\/Accounts\/TwilioloFake\/OutgoingCallerIds\/PNTwilioloFake.json"
+}`
+
+func TestOutgoingCallerIDValidate(t *testing.T) {
+	t.Run("OK - Validation requested", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.PostFn = func(uri string, _ []option.RequestOption, values url.Values) ([]byte, error) {
+			assert.Equal(t, "/OutgoingCallerIds.json", uri)
+			assert.Equal(t, url.Values{
+				"PhoneNumber":  {"+14158675310"},
+				"FriendlyName": {"Customer desk"},
+				"CallDelay":    {"5"},
+			}, values)
+
+			return []byte(`
+			{
+				"account_sid": "TwilioloFake",
+				"call_sid": "CATwilioloFake",
+				"friendly_name": "Customer desk",
+				"phone_number": "+14158675310",
+				"validation_code": "111111"
+			}`), nil
+		}
+
+		service := twiliolo.OutgoingCallerIDService{Client: client}
+		validationRequest, err := service.Validate(&twiliolo.ValidationRequestParams{
+			PhoneNumber:  "+14158675310",
+			FriendlyName: "Customer desk",
+			CallDelay:    5,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "111111", validationRequest.ValidationCode)
+		assert.Equal(t, "CATwilioloFake", validationRequest.CallSid)
+	})
+
+	t.Run("NOK - Missing phone number", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.OutgoingCallerIDService{Client: client}
+
+		validationRequest, err := service.Validate(&twiliolo.ValidationRequestParams{FriendlyName: "Customer desk"})
+
+		assert.Equal(t, twiliolo.ErrOutgoingCallerIDMissingData, err)
+		assert.Nil(t, validationRequest)
+		assert.Equal(t, 0, client.PostCall)
+	})
+}
+
+func TestOutgoingCallerIDGet(t *testing.T) {
+	t.Run("OK - Caller ID retrieved", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/OutgoingCallerIds/PNTwilioloFake.json", uri)
+
+			return []byte(testOutgoingCallerIDResponse), nil
+		}
+
+		service := twiliolo.OutgoingCallerIDService{Client: client}
+		callerID, err := service.Get("PNTwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "+14158675310", callerID.PhoneNumber)
+		assert.Equal(t, "Customer desk", callerID.FriendlyName)
+		assert.Equal(t, time.Date(2010, time.August, 16, 3, 45, 1, 0, time.UTC), callerID.DateCreated)
+		assert.Equal(t, time.Date(2010, time.August, 16, 3, 45, 1, 0, time.UTC), callerID.DateUpdated)
+	})
+
+	t.Run("NOK - Missing sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.OutgoingCallerIDService{Client: client}
+
+		callerID, err := service.Get("")
+
+		assert.Equal(t, twiliolo.ErrOutgoingCallerIDMissingData, err)
+		assert.Nil(t, callerID)
+	})
+}
+
+func TestOutgoingCallerIDRename(t *testing.T) {
+	t.Run("OK - Caller ID renamed", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.PostFn = func(uri string, _ []option.RequestOption, values url.Values) ([]byte, error) {
+			assert.Equal(t, "/OutgoingCallerIds/PNTwilioloFake.json", uri)
+			assert.Equal(t, url.Values{"FriendlyName": {"Support line"}}, values)
+
+			return []byte(`{"sid": "PNTwilioloFake", "friendly_name": "Support line"}`), nil
+		}
+
+		service := twiliolo.OutgoingCallerIDService{Client: client}
+		callerID, err := service.Rename("PNTwilioloFake", "Support line")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Support line", callerID.FriendlyName)
+	})
+
+	t.Run("NOK - Missing sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.OutgoingCallerIDService{Client: client}
+
+		callerID, err := service.Rename("", "Support line")
+
+		assert.Equal(t, twiliolo.ErrOutgoingCallerIDMissingData, err)
+		assert.Nil(t, callerID)
+		assert.Equal(t, 0, client.PostCall)
+	})
+}
+
+func TestOutgoingCallerIDDelete(t *testing.T) {
+	t.Run("OK - Caller ID deleted", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.DeleteFn = func(uri string, _ []option.RequestOption) error {
+			assert.Equal(t, "/OutgoingCallerIds/PNTwilioloFake.json", uri)
+
+			return nil
+		}
+
+		service := twiliolo.OutgoingCallerIDService{Client: client}
+		err := service.Delete("PNTwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, client.DeleteCall)
+	})
+
+	t.Run("NOK - Missing sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.OutgoingCallerIDService{Client: client}
+
+		err := service.Delete("")
+
+		assert.Equal(t, twiliolo.ErrOutgoingCallerIDMissingData, err)
+		assert.Equal(t, 0, client.DeleteCall)
+	})
+}
@@ -0,0 +1,391 @@
+package twiliolo
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// ParticipantServiceInterface is the interface of a ParticipantService
+type ParticipantServiceInterface interface {
+	Create(string, *ParticipantParams, ...option.RequestOption) (*Participant, error)
+	Get(string, string, ...option.RequestOption) (*Participant, error)
+	Update(string, string, *ParticipantUpdate, ...option.RequestOption) (*Participant, error)
+	Mute(string, string, ...option.RequestOption) (*Participant, error)
+	Unmute(string, string, ...option.RequestOption) (*Participant, error)
+	Hold(string, string, string, ...option.RequestOption) (*Participant, error)
+	Unhold(string, string, ...option.RequestOption) (*Participant, error)
+	Coach(string, string, string, ...option.RequestOption) (*Participant, error)
+	Kick(string, string, ...option.RequestOption) error
+	List(string, ...option.RequestOption) (*ParticipantList, error)
+	ListNextPage(*ParticipantList) (*ParticipantList, error)
+	Iter(string, ...option.RequestOption) *Iterator[*Participant]
+	CreateContext(context.Context, string, *ParticipantParams, ...option.RequestOption) (*Participant, error)
+	GetContext(context.Context, string, string, ...option.RequestOption) (*Participant, error)
+	UpdateContext(context.Context, string, string, *ParticipantUpdate, ...option.RequestOption) (*Participant, error)
+	MuteContext(context.Context, string, string, ...option.RequestOption) (*Participant, error)
+	UnmuteContext(context.Context, string, string, ...option.RequestOption) (*Participant, error)
+	HoldContext(context.Context, string, string, string, ...option.RequestOption) (*Participant, error)
+	UnholdContext(context.Context, string, string, ...option.RequestOption) (*Participant, error)
+	CoachContext(context.Context, string, string, string, ...option.RequestOption) (*Participant, error)
+	KickContext(context.Context, string, string, ...option.RequestOption) error
+	ListContext(context.Context, string, ...option.RequestOption) (*ParticipantList, error)
+	ListNextPageContext(context.Context, *ParticipantList) (*ParticipantList, error)
+	IterContext(context.Context, string, ...option.RequestOption) *Iterator[*Participant]
+}
+
+// ParticipantService handles communication with the Conference Participant related methods.
+type ParticipantService service
+
+// ParticipantStatus is the status of a Participant.
+type ParticipantStatus string
+
+// Possible values of a ParticipantStatus.
+const (
+	ParticipantStatusQueued     ParticipantStatus = "queued"
+	ParticipantStatusConnecting ParticipantStatus = "connecting"
+	ParticipantStatusRinging    ParticipantStatus = "ringing"
+	ParticipantStatusConnected  ParticipantStatus = "connected"
+	ParticipantStatusComplete   ParticipantStatus = "complete"
+	ParticipantStatusFailed     ParticipantStatus = "failed"
+)
+
+// Participant represents a Call connected to a Conference.
+type Participant struct {
+	CallSid                string            `json:"call_sid"`
+	ConferenceSid          string            `json:"conference_sid"`
+	AccountSid             string            `json:"account_sid"`
+	Label                  string            `json:"label"`
+	Status                 ParticipantStatus `json:"status"`
+	Muted                  bool              `json:"muted"`
+	Hold                   bool              `json:"hold"`
+	Coaching               bool              `json:"coaching"`
+	CallSidToCoach         string            `json:"call_sid_to_coach"`
+	StartConferenceOnEnter bool              `json:"start_conference_on_enter"`
+	EndConferenceOnExit    bool              `json:"end_conference_on_exit"`
+	DateCreated            time.Time         `json:"date_created"`
+	DateUpdated            time.Time         `json:"date_updated"`
+	URI                    string            `json:"uri"`
+}
+
+// UnmarshalJSON decodes a Participant, parsing its RFC 2822 dates.
+func (p *Participant) UnmarshalJSON(data []byte) error {
+	type participant Participant
+
+	raw := struct {
+		*participant
+		DateCreated string `json:"date_created"`
+		DateUpdated string `json:"date_updated"`
+	}{participant: (*participant)(p)}
+
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	p.DateCreated, err = parseDate(raw.DateCreated)
+	if err != nil {
+		return err
+	}
+
+	p.DateUpdated, err = parseDate(raw.DateUpdated)
+
+	return err
+}
+
+// MarshalJSON encodes a Participant, formatting its dates in RFC 2822 like Twilio.
+func (p Participant) MarshalJSON() ([]byte, error) {
+	type participant Participant
+
+	return json.Marshal(struct {
+		participant
+		DateCreated string `json:"date_created"`
+		DateUpdated string `json:"date_updated"`
+	}{
+		participant: participant(p),
+		DateCreated: formatDate(p.DateCreated),
+		DateUpdated: formatDate(p.DateUpdated),
+	})
+}
+
+// ParticipantParams contains the parameters used to add a Participant to a
+// Conference by dialing out. From and To are required.
+type ParticipantParams struct {
+	From                 string
+	To                   string
+	Label                string
+	StatusCallback       string
+	StatusCallbackMethod string
+	// StatusCallbackEvent lists the events sent to StatusCallback: initiated, ringing, answered and completed.
+	StatusCallbackEvent    []string
+	Muted                  bool
+	Beep                   string
+	StartConferenceOnEnter *bool
+	EndConferenceOnExit    bool
+	WaitURL                string
+	// Timeout is the number of seconds to let the call ring, ignored when 0.
+	Timeout        int
+	Record         bool
+	Coaching       bool
+	CallSidToCoach string
+	EarlyMedia     bool
+}
+
+func (p *ParticipantParams) values() url.Values {
+	values := url.Values{}
+	values.Set("From", p.From)
+	values.Set("To", p.To)
+
+	fields := []struct {
+		key   string
+		value string
+	}{
+		{"Label", p.Label},
+		{"StatusCallback", p.StatusCallback},
+		{"StatusCallbackMethod", p.StatusCallbackMethod},
+		{"Beep", p.Beep},
+		{"WaitUrl", p.WaitURL},
+		{"CallSidToCoach", p.CallSidToCoach},
+	}
+
+	for _, field := range fields {
+		if field.value != "" {
+			values.Set(field.key, field.value)
+		}
+	}
+
+	for _, event := range p.StatusCallbackEvent {
+		values.Add("StatusCallbackEvent", event)
+	}
+
+	flags := []struct {
+		key   string
+		value bool
+	}{
+		{"Muted", p.Muted},
+		{"EndConferenceOnExit", p.EndConferenceOnExit},
+		{"Record", p.Record},
+		{"Coaching", p.Coaching},
+		{"EarlyMedia", p.EarlyMedia},
+	}
+
+	for _, flag := range flags {
+		if flag.value {
+			values.Set(flag.key, "true")
+		}
+	}
+
+	if p.StartConferenceOnEnter != nil {
+		values.Set("StartConferenceOnEnter", strconv.FormatBool(*p.StartConferenceOnEnter))
+	}
+
+	if p.Timeout != 0 {
+		values.Set("Timeout", strconv.Itoa(p.Timeout))
+	}
+
+	return values
+}
+
+// ParticipantUpdate contains the fields to update on a Participant, a nil field is left untouched.
+type ParticipantUpdate struct {
+	Muted          *bool
+	Hold           *bool
+	HoldURL        *string
+	HoldMethod     *string
+	AnnounceURL    *string
+	AnnounceMethod *string
+	Coaching       *bool
+	CallSidToCoach *string
+}
+
+func (u *ParticipantUpdate) values() url.Values {
+	values := url.Values{}
+
+	flags := []struct {
+		key   string
+		value *bool
+	}{
+		{"Muted", u.Muted},
+		{"Hold", u.Hold},
+		{"Coaching", u.Coaching},
+	}
+
+	for _, flag := range flags {
+		if flag.value != nil {
+			values.Set(flag.key, strconv.FormatBool(*flag.value))
+		}
+	}
+
+	fields := []struct {
+		key   string
+		value *string
+	}{
+		{"HoldUrl", u.HoldURL},
+		{"HoldMethod", u.HoldMethod},
+		{"AnnounceUrl", u.AnnounceURL},
+		{"AnnounceMethod", u.AnnounceMethod},
+		{"CallSidToCoach", u.CallSidToCoach},
+	}
+
+	for _, field := range fields {
+		if field.value != nil {
+			values.Set(field.key, *field.value)
+		}
+	}
+
+	return values
+}
+
+// Create adds a Participant to a Conference by dialing out to the To number.
+// Doc: https://www.twilio.com/docs/voice/api/conference-participant-resource#create-a-participant-resource
+func (s *ParticipantService) Create(conferenceSid string, params *ParticipantParams, requestOptions ...option.RequestOption) (*Participant, error) {
+	return s.CreateContext(context.Background(), conferenceSid, params, requestOptions...)
+}
+
+// CreateContext performs the same call as Create, bound to the given context.
+func (s *ParticipantService) CreateContext(ctx context.Context, conferenceSid string, params *ParticipantParams, requestOptions ...option.RequestOption) (*Participant, error) {
+	if conferenceSid == "" || params == nil || params.From == "" || params.To == "" {
+		return nil, ErrParticipantMissingData
+	}
+
+	return s.post(ctx, "/Conferences/"+conferenceSid+"/Participants.json", requestOptions, params.values())
+}
+
+// Get performs a call to the twilio API to retrieve the Participant of a Conference with its Call Sid.
+// Doc: https://www.twilio.com/docs/voice/api/conference-participant-resource#fetch-a-participant-resource
+func (s *ParticipantService) Get(conferenceSid, callSid string, requestOptions ...option.RequestOption) (*Participant, error) {
+	return s.GetContext(context.Background(), conferenceSid, callSid, requestOptions...)
+}
+
+// GetContext performs the same call as Get, bound to the given context.
+func (s *ParticipantService) GetContext(ctx context.Context, conferenceSid, callSid string, requestOptions ...option.RequestOption) (*Participant, error) {
+	if conferenceSid == "" || callSid == "" {
+		return nil, ErrParticipantMissingData
+	}
+
+	res, err := s.Client.GetContext(ctx, participantURI(conferenceSid, callSid), requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	participant := new(Participant)
+	err = json.Unmarshal(res, participant)
+
+	return participant, err
+}
+
+// Update performs a partial update of a Participant, only the fields set in the ParticipantUpdate are sent.
+// Doc: https://www.twilio.com/docs/voice/api/conference-participant-resource#update-a-participant-resource
+func (s *ParticipantService) Update(conferenceSid, callSid string, update *ParticipantUpdate, requestOptions ...option.RequestOption) (*Participant, error) {
+	return s.UpdateContext(context.Background(), conferenceSid, callSid, update, requestOptions...)
+}
+
+// UpdateContext performs the same call as Update, bound to the given context.
+func (s *ParticipantService) UpdateContext(ctx context.Context, conferenceSid, callSid string, update *ParticipantUpdate, requestOptions ...option.RequestOption) (*Participant, error) {
+	if conferenceSid == "" || callSid == "" || update == nil {
+		return nil, ErrParticipantMissingData
+	}
+
+	return s.post(ctx, participantURI(conferenceSid, callSid), requestOptions, update.values())
+}
+
+// Mute mutes a Participant, who can still hear the Conference.
+func (s *ParticipantService) Mute(conferenceSid, callSid string, requestOptions ...option.RequestOption) (*Participant, error) {
+	return s.MuteContext(context.Background(), conferenceSid, callSid, requestOptions...)
+}
+
+// MuteContext performs the same call as Mute, bound to the given context.
+func (s *ParticipantService) MuteContext(ctx context.Context, conferenceSid, callSid string, requestOptions ...option.RequestOption) (*Participant, error) {
+	return s.UpdateContext(ctx, conferenceSid, callSid, &ParticipantUpdate{Muted: Bool(true)}, requestOptions...)
+}
+
+// Unmute unmutes a Participant.
+func (s *ParticipantService) Unmute(conferenceSid, callSid string, requestOptions ...option.RequestOption) (*Participant, error) {
+	return s.UnmuteContext(context.Background(), conferenceSid, callSid, requestOptions...)
+}
+
+// UnmuteContext performs the same call as Unmute, bound to the given context.
+func (s *ParticipantService) UnmuteContext(ctx context.Context, conferenceSid, callSid string, requestOptions ...option.RequestOption) (*Participant, error) {
+	return s.UpdateContext(ctx, conferenceSid, callSid, &ParticipantUpdate{Muted: Bool(false)}, requestOptions...)
+}
+
+// Hold puts a Participant on hold, playing the music or TwiML of holdURL
+// when not empty, the Twilio default music otherwise.
+func (s *ParticipantService) Hold(conferenceSid, callSid, holdURL string, requestOptions ...option.RequestOption) (*Participant, error) {
+	return s.HoldContext(context.Background(), conferenceSid, callSid, holdURL, requestOptions...)
+}
+
+// HoldContext performs the same call as Hold, bound to the given context.
+func (s *ParticipantService) HoldContext(ctx context.Context, conferenceSid, callSid, holdURL string, requestOptions ...option.RequestOption) (*Participant, error) {
+	update := &ParticipantUpdate{Hold: Bool(true)}
+	if holdURL != "" {
+		update.HoldURL = String(holdURL)
+	}
+
+	return s.UpdateContext(ctx, conferenceSid, callSid, update, requestOptions...)
+}
+
+// Unhold takes a Participant back from hold into the Conference.
+func (s *ParticipantService) Unhold(conferenceSid, callSid string, requestOptions ...option.RequestOption) (*Participant, error) {
+	return s.UnholdContext(context.Background(), conferenceSid, callSid, requestOptions...)
+}
+
+// UnholdContext performs the same call as Unhold, bound to the given context.
+func (s *ParticipantService) UnholdContext(ctx context.Context, conferenceSid, callSid string, requestOptions ...option.RequestOption) (*Participant, error) {
+	return s.UpdateContext(ctx, conferenceSid, callSid, &ParticipantUpdate{Hold: Bool(false)}, requestOptions...)
+}
+
+// Coach makes a Participant coach another one, only heard by the coached Participant.
+func (s *ParticipantService) Coach(conferenceSid, callSid, callSidToCoach string, requestOptions ...option.RequestOption) (*Participant, error) {
+	return s.CoachContext(context.Background(), conferenceSid, callSid, callSidToCoach, requestOptions...)
+}
+
+// CoachContext performs the same call as Coach, bound to the given context.
+func (s *ParticipantService) CoachContext(ctx context.Context, conferenceSid, callSid, callSidToCoach string, requestOptions ...option.RequestOption) (*Participant, error) {
+	if callSidToCoach == "" {
+		return nil, ErrParticipantMissingData
+	}
+
+	update := &ParticipantUpdate{Coaching: Bool(true), CallSidToCoach: String(callSidToCoach)}
+
+	return s.UpdateContext(ctx, conferenceSid, callSid, update, requestOptions...)
+}
+
+// Kick removes a Participant from a Conference, hanging up its Call.
+// Doc: https://www.twilio.com/docs/voice/api/conference-participant-resource#delete-a-participant-resource
+func (s *ParticipantService) Kick(conferenceSid, callSid string, requestOptions ...option.RequestOption) error {
+	return s.KickContext(context.Background(), conferenceSid, callSid, requestOptions...)
+}
+
+// KickContext performs the same call as Kick, bound to the given context.
+func (s *ParticipantService) KickContext(ctx context.Context, conferenceSid, callSid string, requestOptions ...option.RequestOption) error {
+	if conferenceSid == "" || callSid == "" {
+		return ErrParticipantMissingData
+	}
+
+	return s.Client.DeleteContext(ctx, participantURI(conferenceSid, callSid), requestOptions)
+}
+
+func (s *ParticipantService) post(ctx context.Context, uri string, requestOptions []option.RequestOption, values url.Values) (*Participant, error) {
+	body, err := s.Client.PostContext(ctx, uri, requestOptions, values)
+	if err != nil {
+		return nil, err
+	}
+
+	var participant Participant
+
+	err = json.Unmarshal(body, &participant)
+	if err != nil {
+		return nil, err
+	}
+
+	return &participant, nil
+}
+
+func participantURI(conferenceSid, callSid string) string {
+	return "/Conferences/" + conferenceSid + "/Participants/" + callSid + ".json"
+}
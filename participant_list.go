@@ -0,0 +1,86 @@
+package twiliolo
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// ParticipantList represents the response of the Twilio API when calling /Conferences/{Sid}/Participants.json
+type ParticipantList struct {
+	Page            int            `json:"page"`
+	PageSize        int            `json:"page_size"`
+	URI             string         `json:"uri"`
+	FirstPageURI    string         `json:"first_page_uri"`
+	NextPageURI     string         `json:"next_page_uri"`
+	PreviousPageURI string         `json:"previous_page_uri"`
+	Participants    []*Participant `json:"participants"`
+}
+
+// List retrieves the first page of the Participants of a Conference, filtered
+// with the Muted, Hold and Coaching options.
+// Doc: https://www.twilio.com/docs/voice/api/conference-participant-resource#read-multiple-participant-resources
+func (s *ParticipantService) List(conferenceSid string, requestOptions ...option.RequestOption) (*ParticipantList, error) {
+	return s.ListContext(context.Background(), conferenceSid, requestOptions...)
+}
+
+// ListContext performs the same call as List, bound to the given context.
+func (s *ParticipantService) ListContext(ctx context.Context, conferenceSid string, requestOptions ...option.RequestOption) (*ParticipantList, error) {
+	if conferenceSid == "" {
+		return nil, ErrParticipantMissingData
+	}
+
+	body, err := s.Client.GetContext(ctx, "/Conferences/"+conferenceSid+"/Participants.json", requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	participantList := new(ParticipantList)
+	err = json.Unmarshal(body, participantList)
+
+	return participantList, err
+}
+
+// ListNextPage retrieves the next page of a given ParticipantList by following its NextPageURI.
+// If an empty NextPageURI is present in the struct it'll return an error
+func (s *ParticipantService) ListNextPage(previousList *ParticipantList) (*ParticipantList, error) {
+	return s.ListNextPageContext(context.Background(), previousList)
+}
+
+// ListNextPageContext performs the same call as ListNextPage, bound to the given context.
+func (s *ParticipantService) ListNextPageContext(ctx context.Context, previousList *ParticipantList) (*ParticipantList, error) {
+	if previousList == nil || previousList.NextPageURI == "" {
+		return nil, ErrNoNextPage
+	}
+
+	body, err := s.Client.GetContext(ctx, previousList.NextPageURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	participantList := new(ParticipantList)
+	err = json.Unmarshal(body, participantList)
+
+	return participantList, err
+}
+
+// Iter returns an Iterator over all the Participants of a Conference matching the given options.
+func (s *ParticipantService) Iter(conferenceSid string, requestOptions ...option.RequestOption) *Iterator[*Participant] {
+	return s.IterContext(context.Background(), conferenceSid, requestOptions...)
+}
+
+// IterContext performs the same calls as Iter, bound to the given context.
+func (s *ParticipantService) IterContext(ctx context.Context, conferenceSid string, requestOptions ...option.RequestOption) *Iterator[*Participant] {
+	it := newIterator(ctx, s.Client, "/Conferences/"+conferenceSid+"/Participants.json", requestOptions, func(body []byte) ([]*Participant, string, error) {
+		list := new(ParticipantList)
+		err := json.Unmarshal(body, list)
+
+		return list.Participants, list.NextPageURI, err
+	})
+	if conferenceSid == "" {
+		it.err = ErrParticipantMissingData
+	}
+
+	return it
+}
@@ -0,0 +1,84 @@
+package twiliolo_test
+
+import (
+	"testing"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParticipantList(t *testing.T) {
+	t.Run("OK - Muted participants", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/Conferences/CFTwilioloFake/Participants.json", uri)
+			assert.Equal(t, []option.RequestOption{option.Muted(true)}, requestOptions)
+
+			return []byte(`
+			{
+				"page": 0,
+				"page_size": 50,
+				"participants": [{"call_sid": "CATwilioloFake", "muted": true}, {"call_sid": "CATwilioloFake2", "muted": true}]
+			}`), nil
+		}
+
+		service := twiliolo.ParticipantService{Client: client}
+		list, err := service.List("CFTwilioloFake", option.Muted(true))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(list.Participants))
+		assert.Equal(t, "CATwilioloFake2", list.Participants[1].CallSid)
+	})
+
+	t.Run("NOK - Missing conference sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.ParticipantService{Client: client}
+
+		list, err := service.List("")
+
+		assert.Equal(t, twiliolo.ErrParticipantMissingData, err)
+		assert.Nil(t, list)
+	})
+}
+
+func TestParticipantListNextPage(t *testing.T) {
+	t.Run("OK - Follow next page URI", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/2010-04-01/Accounts/TwilioloFake/Conferences/CFTwilioloFake/Participants.json?Page=1", uri)
+			assert.Empty(t, requestOptions)
+
+			return []byte(`{"page": 1, "participants": [{"call_sid": "CATwilioloFake3"}]}`), nil
+		}
+
+		service := twiliolo.ParticipantService{Client: client}
+		list, err := service.ListNextPage(&twiliolo.ParticipantList{NextPageURI: "/2010-04-01/Accounts/TwilioloFake/Conferences/CFTwilioloFake/Participants.json?Page=1"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "CATwilioloFake3", list.Participants[0].CallSid)
+	})
+
+	t.Run("NOK - No next page", func(t *testing.T) {
+		service := twiliolo.ParticipantService{Client: new(internal.MockAPIClient)}
+
+		list, err := service.ListNextPage(&twiliolo.ParticipantList{})
+
+		assert.Equal(t, twiliolo.ErrNoNextPage, err)
+		assert.Nil(t, list)
+	})
+}
+
+func TestParticipantIter(t *testing.T) {
+	t.Run("NOK - Missing conference sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.ParticipantService{Client: client}
+
+		it := service.Iter("")
+
+		assert.False(t, it.Next())
+		assert.Equal(t, twiliolo.ErrParticipantMissingData, it.Err())
+		assert.Equal(t, 0, client.GetCall)
+	})
+}
@@ -0,0 +1,233 @@
+package twiliolo_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+const testParticipantURI = "/Conferences/CFTwilioloFake/Participants/CATwilioloFake.json"
+
+const testParticipantResponse = `
+{
+	"call_sid": "CATwilioloFake",
+	"conference_sid": "CFTwilioloFake",
+	"account_sid": "TwilioloFake",
+	"label": "customer",
+	"status": "connected",
+	"muted": true,
+	"hold": false,
+	"coaching": false,
+	"start_conference_on_enter": true,
+	"end_conference_on_exit": false,
+	"date_created": "Mon, 16 Aug 2010 03:45:01 +0000",
+	"date_updated": "Mon, 16 Aug 2010 03:45:01 +0000",
+	"uri": "\/2010-04-01\/Accounts\/TwilioloFake\/Conferences\/CFTwilioloFake\/Participants\/CATwilioloFake.json"
+}`
+
+func TestParticipantCreate(t *testing.T) {
+	t.Run("OK - Participant dialed out", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.PostFn = func(uri string, _ []option.RequestOption, values url.Values) ([]byte, error) {
+			assert.Equal(t, "/Conferences/CFTwilioloFake/Participants.json", uri)
+			assert.Equal(t, "+15017122661", values.Get("From"))
+			assert.Equal(t, "+15558675310", values.Get("To"))
+			assert.Equal(t, "customer", values.Get("Label"))
+			assert.Equal(t, []string{"ringing", "answered"}, values["StatusCallbackEvent"])
+			assert.Equal(t, "true", values.Get("Muted"))
+			assert.Equal(t, "false", values.Get("StartConferenceOnEnter"))
+			assert.Equal(t, "30", values.Get("Timeout"))
+			assert.NotContains(t, values, "Record")
+			assert.NotContains(t, values, "Coaching")
+
+			return []byte(testParticipantResponse), nil
+		}
+
+		service := twiliolo.ParticipantService{Client: client}
+		participant, err := service.Create("CFTwilioloFake", &twiliolo.ParticipantParams{
+			From:                   "+15017122661",
+			To:                     "+15558675310",
+			Label:                  "customer",
+			StatusCallbackEvent:    []string{"ringing", "answered"},
+			Muted:                  true,
+			StartConferenceOnEnter: twiliolo.Bool(false),
+			Timeout:                30,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "CATwilioloFake", participant.CallSid)
+		assert.Equal(t, twiliolo.ParticipantStatusConnected, participant.Status)
+		assert.Equal(t, time.Date(2010, time.August, 16, 3, 45, 1, 0, time.UTC), participant.DateCreated)
+		assert.Equal(t, time.Date(2010, time.August, 16, 3, 45, 1, 0, time.UTC), participant.DateUpdated)
+		assert.True(t, participant.Muted)
+	})
+
+	t.Run("NOK - Missing To", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.ParticipantService{Client: client}
+
+		participant, err := service.Create("CFTwilioloFake", &twiliolo.ParticipantParams{From: "+15017122661"})
+
+		assert.Equal(t, twiliolo.ErrParticipantMissingData, err)
+		assert.Nil(t, participant)
+		assert.Equal(t, 0, client.PostCall)
+	})
+}
+
+func TestParticipantGet(t *testing.T) {
+	t.Run("OK - Participant retrieved", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, testParticipantURI, uri)
+
+			return []byte(testParticipantResponse), nil
+		}
+
+		service := twiliolo.ParticipantService{Client: client}
+		participant, err := service.Get("CFTwilioloFake", "CATwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "customer", participant.Label)
+		assert.True(t, participant.StartConferenceOnEnter)
+	})
+
+	t.Run("NOK - Missing call sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.ParticipantService{Client: client}
+
+		participant, err := service.Get("CFTwilioloFake", "")
+
+		assert.Equal(t, twiliolo.ErrParticipantMissingData, err)
+		assert.Nil(t, participant)
+	})
+}
+
+func TestParticipantUpdate(t *testing.T) {
+	client := new(internal.MockAPIClient)
+	client.PostFn = func(uri string, _ []option.RequestOption, values url.Values) ([]byte, error) {
+		assert.Equal(t, testParticipantURI, uri)
+		assert.Equal(t, url.Values{"Muted": {"false"}, "AnnounceUrl": {"https://example.com/announce"}}, values)
+
+		return []byte(testParticipantResponse), nil
+	}
+
+	service := twiliolo.ParticipantService{Client: client}
+	_, err := service.Update("CFTwilioloFake", "CATwilioloFake", &twiliolo.ParticipantUpdate{
+		Muted:       twiliolo.Bool(false),
+		AnnounceURL: twiliolo.String("https://example.com/announce"),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, client.PostCall)
+}
+
+func TestParticipantHelpers(t *testing.T) {
+	cases := []struct {
+		name     string
+		call     func(*twiliolo.ParticipantService) (*twiliolo.Participant, error)
+		expected url.Values
+	}{
+		{
+			name: "Mute",
+			call: func(s *twiliolo.ParticipantService) (*twiliolo.Participant, error) {
+				return s.Mute("CFTwilioloFake", "CATwilioloFake")
+			},
+			expected: url.Values{"Muted": {"true"}},
+		},
+		{
+			name: "Unmute",
+			call: func(s *twiliolo.ParticipantService) (*twiliolo.Participant, error) {
+				return s.Unmute("CFTwilioloFake", "CATwilioloFake")
+			},
+			expected: url.Values{"Muted": {"false"}},
+		},
+		{
+			name: "Hold with music",
+			call: func(s *twiliolo.ParticipantService) (*twiliolo.Participant, error) {
+				return s.Hold("CFTwilioloFake", "CATwilioloFake", "https://example.com/music.mp3")
+			},
+			expected: url.Values{"Hold": {"true"}, "HoldUrl": {"https://example.com/music.mp3"}},
+		},
+		{
+			name: "Hold with default music",
+			call: func(s *twiliolo.ParticipantService) (*twiliolo.Participant, error) {
+				return s.Hold("CFTwilioloFake", "CATwilioloFake", "")
+			},
+			expected: url.Values{"Hold": {"true"}},
+		},
+		{
+			name: "Unhold",
+			call: func(s *twiliolo.ParticipantService) (*twiliolo.Participant, error) {
+				return s.Unhold("CFTwilioloFake", "CATwilioloFake")
+			},
+			expected: url.Values{"Hold": {"false"}},
+		},
+		{
+			name: "Coach",
+			call: func(s *twiliolo.ParticipantService) (*twiliolo.Participant, error) {
+				return s.Coach("CFTwilioloFake", "CATwilioloFake", "CATwilioloFake2")
+			},
+			expected: url.Values{"Coaching": {"true"}, "CallSidToCoach": {"CATwilioloFake2"}},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run("OK - "+c.name, func(t *testing.T) {
+			client := new(internal.MockAPIClient)
+			client.PostFn = func(uri string, _ []option.RequestOption, values url.Values) ([]byte, error) {
+				assert.Equal(t, testParticipantURI, uri)
+				assert.Equal(t, c.expected, values)
+
+				return []byte(testParticipantResponse), nil
+			}
+
+			participant, err := c.call(&twiliolo.ParticipantService{Client: client})
+
+			assert.NoError(t, err)
+			assert.Equal(t, "CATwilioloFake", participant.CallSid)
+		})
+	}
+
+	t.Run("NOK - Coach without call to coach", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.ParticipantService{Client: client}
+
+		participant, err := service.Coach("CFTwilioloFake", "CATwilioloFake", "")
+
+		assert.Equal(t, twiliolo.ErrParticipantMissingData, err)
+		assert.Nil(t, participant)
+		assert.Equal(t, 0, client.PostCall)
+	})
+}
+
+func TestParticipantKick(t *testing.T) {
+	t.Run("OK - Participant kicked", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.DeleteFn = func(uri string, _ []option.RequestOption) error {
+			assert.Equal(t, testParticipantURI, uri)
+
+			return nil
+		}
+
+		service := twiliolo.ParticipantService{Client: client}
+		err := service.Kick("CFTwilioloFake", "CATwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, client.DeleteCall)
+	})
+
+	t.Run("NOK - Missing conference sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.ParticipantService{Client: client}
+
+		err := service.Kick("", "CATwilioloFake")
+
+		assert.Equal(t, twiliolo.ErrParticipantMissingData, err)
+		assert.Equal(t, 0, client.DeleteCall)
+	})
+}
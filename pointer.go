@@ -0,0 +1,16 @@
+package twiliolo
+
+// String returns a pointer to the given string, to set the optional fields of the update structs.
+func String(value string) *string {
+	return &value
+}
+
+// Bool returns a pointer to the given bool, to set the optional fields of the update structs.
+func Bool(value bool) *bool {
+	return &value
+}
+
+// Int returns a pointer to the given int, to set the optional fields of the update structs.
+func Int(value int) *int {
+	return &value
+}
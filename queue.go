@@ -0,0 +1,195 @@
+package twiliolo
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// QueueServiceInterface is the interface of a QueueService
+type QueueServiceInterface interface {
+	Create(string, int, ...option.RequestOption) (*Queue, error)
+	Get(string, ...option.RequestOption) (*Queue, error)
+	Update(string, *QueueUpdate, ...option.RequestOption) (*Queue, error)
+	Delete(string, ...option.RequestOption) error
+	List(...option.RequestOption) (*QueueList, error)
+	ListNextPage(*QueueList) (*QueueList, error)
+	Iter(...option.RequestOption) *Iterator[*Queue]
+	CreateContext(context.Context, string, int, ...option.RequestOption) (*Queue, error)
+	GetContext(context.Context, string, ...option.RequestOption) (*Queue, error)
+	UpdateContext(context.Context, string, *QueueUpdate, ...option.RequestOption) (*Queue, error)
+	DeleteContext(context.Context, string, ...option.RequestOption) error
+	ListContext(context.Context, ...option.RequestOption) (*QueueList, error)
+	ListNextPageContext(context.Context, *QueueList) (*QueueList, error)
+	IterContext(context.Context, ...option.RequestOption) *Iterator[*Queue]
+}
+
+// QueueService handles communication with the Queue related methods.
+type QueueService service
+
+// Queue represents a Twilio call queue, filled by the <Enqueue> TwiML verb.
+type Queue struct {
+	Sid             string    `json:"sid"`
+	AccountSid      string    `json:"account_sid"`
+	FriendlyName    string    `json:"friendly_name"`
+	CurrentSize     int       `json:"current_size"`
+	MaxSize         int       `json:"max_size"`
+	AverageWaitTime int       `json:"average_wait_time"`
+	DateCreated     time.Time `json:"date_created"`
+	DateUpdated     time.Time `json:"date_updated"`
+	URI             string    `json:"uri"`
+}
+
+// UnmarshalJSON decodes a Queue, parsing its RFC 2822 dates.
+func (q *Queue) UnmarshalJSON(data []byte) error {
+	type queue Queue
+
+	raw := struct {
+		*queue
+		DateCreated string `json:"date_created"`
+		DateUpdated string `json:"date_updated"`
+	}{queue: (*queue)(q)}
+
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	q.DateCreated, err = parseDate(raw.DateCreated)
+	if err != nil {
+		return err
+	}
+
+	q.DateUpdated, err = parseDate(raw.DateUpdated)
+
+	return err
+}
+
+// MarshalJSON encodes a Queue, formatting its dates in RFC 2822 like Twilio.
+func (q Queue) MarshalJSON() ([]byte, error) {
+	type queue Queue
+
+	return json.Marshal(struct {
+		queue
+		DateCreated string `json:"date_created"`
+		DateUpdated string `json:"date_updated"`
+	}{
+		queue:       queue(q),
+		DateCreated: formatDate(q.DateCreated),
+		DateUpdated: formatDate(q.DateUpdated),
+	})
+}
+
+// QueueUpdate contains the fields to update on a Queue, a nil field is left untouched.
+type QueueUpdate struct {
+	FriendlyName *string
+	MaxSize      *int
+}
+
+func (u *QueueUpdate) values() url.Values {
+	values := url.Values{}
+
+	if u.FriendlyName != nil {
+		values.Set("FriendlyName", *u.FriendlyName)
+	}
+
+	if u.MaxSize != nil {
+		values.Set("MaxSize", strconv.Itoa(*u.MaxSize))
+	}
+
+	return values
+}
+
+// Create creates a new Queue, the Twilio default max size is used when maxSize is 0.
+// Doc: https://www.twilio.com/docs/voice/api/queue-resource#create-a-queue-resource
+func (s *QueueService) Create(friendlyName string, maxSize int, requestOptions ...option.RequestOption) (*Queue, error) {
+	return s.CreateContext(context.Background(), friendlyName, maxSize, requestOptions...)
+}
+
+// CreateContext performs the same call as Create, bound to the given context.
+func (s *QueueService) CreateContext(ctx context.Context, friendlyName string, maxSize int, requestOptions ...option.RequestOption) (*Queue, error) {
+	if friendlyName == "" {
+		return nil, ErrQueueMissingData
+	}
+
+	values := url.Values{}
+	values.Set("FriendlyName", friendlyName)
+	if maxSize != 0 {
+		values.Set("MaxSize", strconv.Itoa(maxSize))
+	}
+
+	return s.post(ctx, "/Queues.json", requestOptions, values)
+}
+
+// Get performs a call to the twilio API to retrieve a Queue with its Sid.
+// Doc: https://www.twilio.com/docs/voice/api/queue-resource#fetch-a-queue-resource
+func (s *QueueService) Get(sid string, requestOptions ...option.RequestOption) (*Queue, error) {
+	return s.GetContext(context.Background(), sid, requestOptions...)
+}
+
+// GetContext performs the same call as Get, bound to the given context.
+func (s *QueueService) GetContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*Queue, error) {
+	if sid == "" {
+		return nil, ErrQueueMissingData
+	}
+
+	res, err := s.Client.GetContext(ctx, "/Queues/"+sid+".json", requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := new(Queue)
+	err = json.Unmarshal(res, queue)
+
+	return queue, err
+}
+
+// Update performs a partial update of a Queue, only the fields set in the QueueUpdate are sent.
+// Doc: https://www.twilio.com/docs/voice/api/queue-resource#update-a-queue-resource
+func (s *QueueService) Update(sid string, update *QueueUpdate, requestOptions ...option.RequestOption) (*Queue, error) {
+	return s.UpdateContext(context.Background(), sid, update, requestOptions...)
+}
+
+// UpdateContext performs the same call as Update, bound to the given context.
+func (s *QueueService) UpdateContext(ctx context.Context, sid string, update *QueueUpdate, requestOptions ...option.RequestOption) (*Queue, error) {
+	if sid == "" || update == nil {
+		return nil, ErrQueueMissingData
+	}
+
+	return s.post(ctx, "/Queues/"+sid+".json", requestOptions, update.values())
+}
+
+// Delete removes a Queue, which must be empty.
+// Doc: https://www.twilio.com/docs/voice/api/queue-resource#delete-a-queue-resource
+func (s *QueueService) Delete(sid string, requestOptions ...option.RequestOption) error {
+	return s.DeleteContext(context.Background(), sid, requestOptions...)
+}
+
+// DeleteContext performs the same call as Delete, bound to the given context.
+func (s *QueueService) DeleteContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) error {
+	if sid == "" {
+		return ErrQueueMissingData
+	}
+
+	return s.Client.DeleteContext(ctx, "/Queues/"+sid+".json", requestOptions)
+}
+
+func (s *QueueService) post(ctx context.Context, uri string, requestOptions []option.RequestOption, values url.Values) (*Queue, error) {
+	body, err := s.Client.PostContext(ctx, uri, requestOptions, values)
+	if err != nil {
+		return nil, err
+	}
+
+	var queue Queue
+
+	err = json.Unmarshal(body, &queue)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queue, nil
+}
@@ -0,0 +1,76 @@
+package twiliolo
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// QueueList represents the response of the Twilio API when calling /Queues.json
+type QueueList struct {
+	Page            int      `json:"page"`
+	PageSize        int      `json:"page_size"`
+	URI             string   `json:"uri"`
+	FirstPageURI    string   `json:"first_page_uri"`
+	NextPageURI     string   `json:"next_page_uri"`
+	PreviousPageURI string   `json:"previous_page_uri"`
+	Queues          []*Queue `json:"queues"`
+}
+
+// List retrieves the first page of the Queues of the account.
+// Doc: https://www.twilio.com/docs/voice/api/queue-resource#read-multiple-queue-resources
+func (s *QueueService) List(requestOptions ...option.RequestOption) (*QueueList, error) {
+	return s.ListContext(context.Background(), requestOptions...)
+}
+
+// ListContext performs the same call as List, bound to the given context.
+func (s *QueueService) ListContext(ctx context.Context, requestOptions ...option.RequestOption) (*QueueList, error) {
+	body, err := s.Client.GetContext(ctx, "/Queues.json", requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	queueList := new(QueueList)
+	err = json.Unmarshal(body, queueList)
+
+	return queueList, err
+}
+
+// ListNextPage retrieves the next page of a given QueueList by following its NextPageURI.
+// If an empty NextPageURI is present in the struct it'll return an error
+func (s *QueueService) ListNextPage(previousList *QueueList) (*QueueList, error) {
+	return s.ListNextPageContext(context.Background(), previousList)
+}
+
+// ListNextPageContext performs the same call as ListNextPage, bound to the given context.
+func (s *QueueService) ListNextPageContext(ctx context.Context, previousList *QueueList) (*QueueList, error) {
+	if previousList == nil || previousList.NextPageURI == "" {
+		return nil, ErrNoNextPage
+	}
+
+	body, err := s.Client.GetContext(ctx, previousList.NextPageURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	queueList := new(QueueList)
+	err = json.Unmarshal(body, queueList)
+
+	return queueList, err
+}
+
+// Iter returns an Iterator over all the Queues of the account.
+func (s *QueueService) Iter(requestOptions ...option.RequestOption) *Iterator[*Queue] {
+	return s.IterContext(context.Background(), requestOptions...)
+}
+
+// IterContext performs the same calls as Iter, bound to the given context.
+func (s *QueueService) IterContext(ctx context.Context, requestOptions ...option.RequestOption) *Iterator[*Queue] {
+	return newIterator(ctx, s.Client, "/Queues.json", requestOptions, func(body []byte) ([]*Queue, string, error) {
+		list := new(QueueList)
+		err := json.Unmarshal(body, list)
+
+		return list.Queues, list.NextPageURI, err
+	})
+}
@@ -0,0 +1,59 @@
+package twiliolo_test
+
+import (
+	"testing"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueueList(t *testing.T) {
+	client := new(internal.MockAPIClient)
+	client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+		assert.Equal(t, "/Queues.json", uri)
+		assert.Equal(t, []option.RequestOption{option.PageSize(20)}, requestOptions)
+
+		return []byte(`
+		{
+			"page": 0,
+			"page_size": 20,
+			"queues": [{"sid": "QUTwilioloFake", "friendly_name": "Support"}, {"sid": "QUTwilioloFake2", "friendly_name": "Sales"}]
+		}`), nil
+	}
+
+	service := twiliolo.QueueService{Client: client}
+	list, err := service.List(option.PageSize(20))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(list.Queues))
+	assert.Equal(t, "Sales", list.Queues[1].FriendlyName)
+}
+
+func TestQueueListNextPage(t *testing.T) {
+	t.Run("OK - Follow next page URI", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/2010-04-01/Accounts/TwilioloFake/Queues.json?Page=1", uri)
+			assert.Empty(t, requestOptions)
+
+			return []byte(`{"page": 1, "queues": [{"sid": "QUTwilioloFake3"}]}`), nil
+		}
+
+		service := twiliolo.QueueService{Client: client}
+		list, err := service.ListNextPage(&twiliolo.QueueList{NextPageURI: "/2010-04-01/Accounts/TwilioloFake/Queues.json?Page=1"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "QUTwilioloFake3", list.Queues[0].Sid)
+	})
+
+	t.Run("NOK - No next page", func(t *testing.T) {
+		service := twiliolo.QueueService{Client: new(internal.MockAPIClient)}
+
+		list, err := service.ListNextPage(&twiliolo.QueueList{})
+
+		assert.Equal(t, twiliolo.ErrNoNextPage, err)
+		assert.Nil(t, list)
+	})
+}
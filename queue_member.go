@@ -0,0 +1,153 @@
+package twiliolo
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// QueueMemberServiceInterface is the interface of a QueueMemberService
+type QueueMemberServiceInterface interface {
+	Get(string, string, ...option.RequestOption) (*QueueMember, error)
+	Front(string, ...option.RequestOption) (*QueueMember, error)
+	Dequeue(string, string, string, ...option.RequestOption) (*QueueMember, error)
+	DequeueFront(string, string, ...option.RequestOption) (*QueueMember, error)
+	List(string, ...option.RequestOption) (*QueueMemberList, error)
+	ListNextPage(*QueueMemberList) (*QueueMemberList, error)
+	Iter(string, ...option.RequestOption) *Iterator[*QueueMember]
+	GetContext(context.Context, string, string, ...option.RequestOption) (*QueueMember, error)
+	FrontContext(context.Context, string, ...option.RequestOption) (*QueueMember, error)
+	DequeueContext(context.Context, string, string, string, ...option.RequestOption) (*QueueMember, error)
+	DequeueFrontContext(context.Context, string, string, ...option.RequestOption) (*QueueMember, error)
+	ListContext(context.Context, string, ...option.RequestOption) (*QueueMemberList, error)
+	ListNextPageContext(context.Context, *QueueMemberList) (*QueueMemberList, error)
+	IterContext(context.Context, string, ...option.RequestOption) *Iterator[*QueueMember]
+}
+
+// QueueMemberService handles communication with the Queue Member related methods.
+type QueueMemberService service
+
+// queueMemberFront is the Call Sid placeholder addressing the member at the front of a Queue.
+const queueMemberFront = "Front"
+
+// QueueMember represents a Call waiting in a Queue.
+type QueueMember struct {
+	CallSid      string    `json:"call_sid"`
+	QueueSid     string    `json:"queue_sid"`
+	Position     int       `json:"position"`
+	WaitTime     int       `json:"wait_time"`
+	DateEnqueued time.Time `json:"date_enqueued"`
+	URI          string    `json:"uri"`
+}
+
+// UnmarshalJSON decodes a QueueMember, parsing its RFC 2822 dates.
+func (m *QueueMember) UnmarshalJSON(data []byte) error {
+	type queueMember QueueMember
+
+	raw := struct {
+		*queueMember
+		DateEnqueued string `json:"date_enqueued"`
+	}{queueMember: (*queueMember)(m)}
+
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	m.DateEnqueued, err = parseDate(raw.DateEnqueued)
+
+	return err
+}
+
+// MarshalJSON encodes a QueueMember, formatting its dates in RFC 2822 like Twilio.
+func (m QueueMember) MarshalJSON() ([]byte, error) {
+	type queueMember QueueMember
+
+	return json.Marshal(struct {
+		queueMember
+		DateEnqueued string `json:"date_enqueued"`
+	}{
+		queueMember:  queueMember(m),
+		DateEnqueued: formatDate(m.DateEnqueued),
+	})
+}
+
+// Get performs a call to the twilio API to retrieve the member of a Queue with its Call Sid.
+// Doc: https://www.twilio.com/docs/voice/api/member-resource#fetch-a-member-resource
+func (s *QueueMemberService) Get(queueSid, callSid string, requestOptions ...option.RequestOption) (*QueueMember, error) {
+	return s.GetContext(context.Background(), queueSid, callSid, requestOptions...)
+}
+
+// GetContext performs the same call as Get, bound to the given context.
+func (s *QueueMemberService) GetContext(ctx context.Context, queueSid, callSid string, requestOptions ...option.RequestOption) (*QueueMember, error) {
+	if queueSid == "" || callSid == "" {
+		return nil, ErrQueueMemberMissingData
+	}
+
+	res, err := s.Client.GetContext(ctx, queueMemberURI(queueSid, callSid), requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	member := new(QueueMember)
+	err = json.Unmarshal(res, member)
+
+	return member, err
+}
+
+// Front retrieves the member at the front of a Queue, the one waiting the longest.
+func (s *QueueMemberService) Front(queueSid string, requestOptions ...option.RequestOption) (*QueueMember, error) {
+	return s.FrontContext(context.Background(), queueSid, requestOptions...)
+}
+
+// FrontContext performs the same call as Front, bound to the given context.
+func (s *QueueMemberService) FrontContext(ctx context.Context, queueSid string, requestOptions ...option.RequestOption) (*QueueMember, error) {
+	return s.GetContext(ctx, queueSid, queueMemberFront, requestOptions...)
+}
+
+// Dequeue removes a member from a Queue and redirects its Call to the TwiML served at twimlURL.
+// Doc: https://www.twilio.com/docs/voice/api/member-resource#update-a-member-resource
+func (s *QueueMemberService) Dequeue(queueSid, callSid, twimlURL string, requestOptions ...option.RequestOption) (*QueueMember, error) {
+	return s.DequeueContext(context.Background(), queueSid, callSid, twimlURL, requestOptions...)
+}
+
+// DequeueContext performs the same call as Dequeue, bound to the given context.
+func (s *QueueMemberService) DequeueContext(ctx context.Context, queueSid, callSid, twimlURL string, requestOptions ...option.RequestOption) (*QueueMember, error) {
+	if queueSid == "" || callSid == "" || twimlURL == "" {
+		return nil, ErrQueueMemberMissingData
+	}
+
+	values := url.Values{}
+	values.Set("Url", twimlURL)
+
+	body, err := s.Client.PostContext(ctx, queueMemberURI(queueSid, callSid), requestOptions, values)
+	if err != nil {
+		return nil, err
+	}
+
+	var member QueueMember
+
+	err = json.Unmarshal(body, &member)
+	if err != nil {
+		return nil, err
+	}
+
+	return &member, nil
+}
+
+// DequeueFront removes the member at the front of a Queue and redirects its Call to the TwiML served at twimlURL.
+func (s *QueueMemberService) DequeueFront(queueSid, twimlURL string, requestOptions ...option.RequestOption) (*QueueMember, error) {
+	return s.DequeueFrontContext(context.Background(), queueSid, twimlURL, requestOptions...)
+}
+
+// DequeueFrontContext performs the same call as DequeueFront, bound to the given context.
+func (s *QueueMemberService) DequeueFrontContext(ctx context.Context, queueSid, twimlURL string, requestOptions ...option.RequestOption) (*QueueMember, error) {
+	return s.DequeueContext(ctx, queueSid, queueMemberFront, twimlURL, requestOptions...)
+}
+
+func queueMemberURI(queueSid, callSid string) string {
+	return "/Queues/" + queueSid + "/Members/" + callSid + ".json"
+}
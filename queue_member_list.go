@@ -0,0 +1,85 @@
+package twiliolo
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// QueueMemberList represents the response of the Twilio API when calling /Queues/{Sid}/Members.json
+type QueueMemberList struct {
+	Page            int            `json:"page"`
+	PageSize        int            `json:"page_size"`
+	URI             string         `json:"uri"`
+	FirstPageURI    string         `json:"first_page_uri"`
+	NextPageURI     string         `json:"next_page_uri"`
+	PreviousPageURI string         `json:"previous_page_uri"`
+	QueueMembers    []*QueueMember `json:"queue_members"`
+}
+
+// List retrieves the first page of the members of a Queue, ordered by position.
+// Doc: https://www.twilio.com/docs/voice/api/member-resource#read-multiple-member-resources
+func (s *QueueMemberService) List(queueSid string, requestOptions ...option.RequestOption) (*QueueMemberList, error) {
+	return s.ListContext(context.Background(), queueSid, requestOptions...)
+}
+
+// ListContext performs the same call as List, bound to the given context.
+func (s *QueueMemberService) ListContext(ctx context.Context, queueSid string, requestOptions ...option.RequestOption) (*QueueMemberList, error) {
+	if queueSid == "" {
+		return nil, ErrQueueMemberMissingData
+	}
+
+	body, err := s.Client.GetContext(ctx, "/Queues/"+queueSid+"/Members.json", requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	memberList := new(QueueMemberList)
+	err = json.Unmarshal(body, memberList)
+
+	return memberList, err
+}
+
+// ListNextPage retrieves the next page of a given QueueMemberList by following its NextPageURI.
+// If an empty NextPageURI is present in the struct it'll return an error
+func (s *QueueMemberService) ListNextPage(previousList *QueueMemberList) (*QueueMemberList, error) {
+	return s.ListNextPageContext(context.Background(), previousList)
+}
+
+// ListNextPageContext performs the same call as ListNextPage, bound to the given context.
+func (s *QueueMemberService) ListNextPageContext(ctx context.Context, previousList *QueueMemberList) (*QueueMemberList, error) {
+	if previousList == nil || previousList.NextPageURI == "" {
+		return nil, ErrNoNextPage
+	}
+
+	body, err := s.Client.GetContext(ctx, previousList.NextPageURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	memberList := new(QueueMemberList)
+	err = json.Unmarshal(body, memberList)
+
+	return memberList, err
+}
+
+// Iter returns an Iterator over all the members of a Queue.
+func (s *QueueMemberService) Iter(queueSid string, requestOptions ...option.RequestOption) *Iterator[*QueueMember] {
+	return s.IterContext(context.Background(), queueSid, requestOptions...)
+}
+
+// IterContext performs the same calls as Iter, bound to the given context.
+func (s *QueueMemberService) IterContext(ctx context.Context, queueSid string, requestOptions ...option.RequestOption) *Iterator[*QueueMember] {
+	it := newIterator(ctx, s.Client, "/Queues/"+queueSid+"/Members.json", requestOptions, func(body []byte) ([]*QueueMember, string, error) {
+		list := new(QueueMemberList)
+		err := json.Unmarshal(body, list)
+
+		return list.QueueMembers, list.NextPageURI, err
+	})
+	if queueSid == "" {
+		it.err = ErrQueueMemberMissingData
+	}
+
+	return it
+}
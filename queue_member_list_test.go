@@ -0,0 +1,70 @@
+package twiliolo_test
+
+import (
+	"testing"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueueMemberList(t *testing.T) {
+	t.Run("OK - Members of a queue", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/Queues/QUTwilioloFake/Members.json", uri)
+
+			return []byte(`
+			{
+				"page": 0,
+				"page_size": 50,
+				"queue_members": [{"call_sid": "CATwilioloFake", "position": 1}, {"call_sid": "CATwilioloFake2", "position": 2}]
+			}`), nil
+		}
+
+		service := twiliolo.QueueMemberService{Client: client}
+		list, err := service.List("QUTwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(list.QueueMembers))
+		assert.Equal(t, 2, list.QueueMembers[1].Position)
+	})
+
+	t.Run("NOK - Missing queue sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.QueueMemberService{Client: client}
+
+		list, err := service.List("")
+
+		assert.Equal(t, twiliolo.ErrQueueMemberMissingData, err)
+		assert.Nil(t, list)
+	})
+}
+
+func TestQueueMemberListNextPage(t *testing.T) {
+	t.Run("OK - Follow next page URI", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/2010-04-01/Accounts/TwilioloFake/Queues/QUTwilioloFake/Members.json?Page=1", uri)
+			assert.Empty(t, requestOptions)
+
+			return []byte(`{"page": 1, "queue_members": [{"call_sid": "CATwilioloFake3"}]}`), nil
+		}
+
+		service := twiliolo.QueueMemberService{Client: client}
+		list, err := service.ListNextPage(&twiliolo.QueueMemberList{NextPageURI: "/2010-04-01/Accounts/TwilioloFake/Queues/QUTwilioloFake/Members.json?Page=1"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "CATwilioloFake3", list.QueueMembers[0].CallSid)
+	})
+
+	t.Run("NOK - No next page", func(t *testing.T) {
+		service := twiliolo.QueueMemberService{Client: new(internal.MockAPIClient)}
+
+		list, err := service.ListNextPage(&twiliolo.QueueMemberList{})
+
+		assert.Equal(t, twiliolo.ErrNoNextPage, err)
+		assert.Nil(t, list)
+	})
+}
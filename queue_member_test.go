@@ -0,0 +1,110 @@
+package twiliolo_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+const testQueueMemberResponse = `
+{
+	"call_sid": "CATwilioloFake",
+	"queue_sid": "QUTwilioloFake",
+	"position": 1,
+	"wait_time": 143,
+	"date_enqueued": "Mon, 16 Aug 2010 03:45:01 +0000",
+	"uri": "\/2010-04-01\/Accounts\/TwilioloFake\/Queues\/QUTwilioloFake\/Members\/CATwilioloFake.json"
+}`
+
+func TestQueueMemberGet(t *testing.T) {
+	t.Run("OK - Member retrieved", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/Queues/QUTwilioloFake/Members/CATwilioloFake.json", uri)
+
+			return []byte(testQueueMemberResponse), nil
+		}
+
+		service := twiliolo.QueueMemberService{Client: client}
+		member, err := service.Get("QUTwilioloFake", "CATwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, member.Position)
+		assert.Equal(t, 143, member.WaitTime)
+		assert.Equal(t, time.Date(2010, time.August, 16, 3, 45, 1, 0, time.UTC), member.DateEnqueued)
+	})
+
+	t.Run("NOK - Missing call sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.QueueMemberService{Client: client}
+
+		member, err := service.Get("QUTwilioloFake", "")
+
+		assert.Equal(t, twiliolo.ErrQueueMemberMissingData, err)
+		assert.Nil(t, member)
+	})
+}
+
+func TestQueueMemberFront(t *testing.T) {
+	client := new(internal.MockAPIClient)
+	client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+		assert.Equal(t, "/Queues/QUTwilioloFake/Members/Front.json", uri)
+
+		return []byte(testQueueMemberResponse), nil
+	}
+
+	service := twiliolo.QueueMemberService{Client: client}
+	member, err := service.Front("QUTwilioloFake")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "CATwilioloFake", member.CallSid)
+}
+
+func TestQueueMemberDequeue(t *testing.T) {
+	t.Run("OK - Member redirected", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.PostFn = func(uri string, _ []option.RequestOption, values url.Values) ([]byte, error) {
+			assert.Equal(t, "/Queues/QUTwilioloFake/Members/CATwilioloFake.json", uri)
+			assert.Equal(t, url.Values{"Url": {"https://example.com/agent.xml"}}, values)
+
+			return []byte(testQueueMemberResponse), nil
+		}
+
+		service := twiliolo.QueueMemberService{Client: client}
+		member, err := service.Dequeue("QUTwilioloFake", "CATwilioloFake", "https://example.com/agent.xml")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "CATwilioloFake", member.CallSid)
+	})
+
+	t.Run("OK - Front member redirected", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.PostFn = func(uri string, _ []option.RequestOption, _ url.Values) ([]byte, error) {
+			assert.Equal(t, "/Queues/QUTwilioloFake/Members/Front.json", uri)
+
+			return []byte(testQueueMemberResponse), nil
+		}
+
+		service := twiliolo.QueueMemberService{Client: client}
+		_, err := service.DequeueFront("QUTwilioloFake", "https://example.com/agent.xml")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, client.PostCall)
+	})
+
+	t.Run("NOK - Missing TwiML URL", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.QueueMemberService{Client: client}
+
+		member, err := service.Dequeue("QUTwilioloFake", "CATwilioloFake", "")
+
+		assert.Equal(t, twiliolo.ErrQueueMemberMissingData, err)
+		assert.Nil(t, member)
+		assert.Equal(t, 0, client.PostCall)
+	})
+}
@@ -0,0 +1,156 @@
+package twiliolo_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+const testQueueResponse = `
+{
+	"sid": "QUTwilioloFake",
+	"account_sid": "TwilioloFake",
+	"friendly_name": "Support",
+	"current_size": 2,
+	"max_size": 50,
+	"average_wait_time": 45,
+	"date_created": "Mon, 16 Aug 2010 03:45:01 +0000",
+	"date_updated": "Mon, 16 Aug 2010 03:45:01 +0000",
+	"uri": "\/2010-04-01\/Accounts\/TwilioloFake\/Queues\/QUTwilioloFake.json"
+}`
+
+func TestQueueCreate(t *testing.T) {
+	t.Run("OK - Queue created", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.PostFn = func(uri string, _ []option.RequestOption, values url.Values) ([]byte, error) {
+			assert.Equal(t, "/Queues.json", uri)
+			assert.Equal(t, url.Values{"FriendlyName": {"Support"}, "MaxSize": {"50"}}, values)
+
+			return []byte(testQueueResponse), nil
+		}
+
+		service := twiliolo.QueueService{Client: client}
+		queue, err := service.Create("Support", 50)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "QUTwilioloFake", queue.Sid)
+		assert.Equal(t, 50, queue.MaxSize)
+		assert.Equal(t, 2, queue.CurrentSize)
+		assert.Equal(t, 45, queue.AverageWaitTime)
+		assert.Equal(t, time.Date(2010, time.August, 16, 3, 45, 1, 0, time.UTC), queue.DateCreated)
+		assert.Equal(t, time.Date(2010, time.August, 16, 3, 45, 1, 0, time.UTC), queue.DateUpdated)
+	})
+
+	t.Run("OK - Default max size", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.PostFn = func(_ string, _ []option.RequestOption, values url.Values) ([]byte, error) {
+			assert.NotContains(t, values, "MaxSize")
+
+			return []byte(testQueueResponse), nil
+		}
+
+		service := twiliolo.QueueService{Client: client}
+		_, err := service.Create("Support", 0)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("NOK - Missing friendly name", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.QueueService{Client: client}
+
+		queue, err := service.Create("", 50)
+
+		assert.Equal(t, twiliolo.ErrQueueMissingData, err)
+		assert.Nil(t, queue)
+		assert.Equal(t, 0, client.PostCall)
+	})
+}
+
+func TestQueueGet(t *testing.T) {
+	t.Run("OK - Queue retrieved", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/Queues/QUTwilioloFake.json", uri)
+
+			return []byte(testQueueResponse), nil
+		}
+
+		service := twiliolo.QueueService{Client: client}
+		queue, err := service.Get("QUTwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Support", queue.FriendlyName)
+	})
+
+	t.Run("NOK - Missing sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.QueueService{Client: client}
+
+		queue, err := service.Get("")
+
+		assert.Equal(t, twiliolo.ErrQueueMissingData, err)
+		assert.Nil(t, queue)
+	})
+}
+
+func TestQueueUpdate(t *testing.T) {
+	t.Run("OK - Only set fields are sent", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.PostFn = func(uri string, _ []option.RequestOption, values url.Values) ([]byte, error) {
+			assert.Equal(t, "/Queues/QUTwilioloFake.json", uri)
+			assert.Equal(t, url.Values{"MaxSize": {"200"}}, values)
+
+			return []byte(`{"sid": "QUTwilioloFake", "max_size": 200}`), nil
+		}
+
+		service := twiliolo.QueueService{Client: client}
+		queue, err := service.Update("QUTwilioloFake", &twiliolo.QueueUpdate{MaxSize: twiliolo.Int(200)})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 200, queue.MaxSize)
+	})
+
+	t.Run("NOK - Missing update", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.QueueService{Client: client}
+
+		queue, err := service.Update("QUTwilioloFake", nil)
+
+		assert.Equal(t, twiliolo.ErrQueueMissingData, err)
+		assert.Nil(t, queue)
+		assert.Equal(t, 0, client.PostCall)
+	})
+}
+
+func TestQueueDelete(t *testing.T) {
+	t.Run("OK - Queue deleted", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.DeleteFn = func(uri string, _ []option.RequestOption) error {
+			assert.Equal(t, "/Queues/QUTwilioloFake.json", uri)
+
+			return nil
+		}
+
+		service := twiliolo.QueueService{Client: client}
+		err := service.Delete("QUTwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, client.DeleteCall)
+	})
+
+	t.Run("NOK - Missing sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.QueueService{Client: client}
+
+		err := service.Delete("")
+
+		assert.Equal(t, twiliolo.ErrQueueMissingData, err)
+		assert.Equal(t, 0, client.DeleteCall)
+	})
+}
@@ -0,0 +1,189 @@
+package twiliolo
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// RecordingServiceInterface is the interface of a RecordingService
+type RecordingServiceInterface interface {
+	Get(string, ...option.RequestOption) (*Recording, error)
+	Delete(string, ...option.RequestOption) error
+	Download(string, RecordingFormat, io.Writer, ...option.RequestOption) error
+	List(...option.RequestOption) (*RecordingList, error)
+	ListByCall(string, ...option.RequestOption) (*RecordingList, error)
+	ListByConference(string, ...option.RequestOption) (*RecordingList, error)
+	ListNextPage(*RecordingList) (*RecordingList, error)
+	Iter(...option.RequestOption) *Iterator[*Recording]
+	IterByCall(string, ...option.RequestOption) *Iterator[*Recording]
+	IterByConference(string, ...option.RequestOption) *Iterator[*Recording]
+	GetContext(context.Context, string, ...option.RequestOption) (*Recording, error)
+	DeleteContext(context.Context, string, ...option.RequestOption) error
+	DownloadContext(context.Context, string, RecordingFormat, io.Writer, ...option.RequestOption) error
+	ListContext(context.Context, ...option.RequestOption) (*RecordingList, error)
+	ListByCallContext(context.Context, string, ...option.RequestOption) (*RecordingList, error)
+	ListByConferenceContext(context.Context, string, ...option.RequestOption) (*RecordingList, error)
+	ListNextPageContext(context.Context, *RecordingList) (*RecordingList, error)
+	IterContext(context.Context, ...option.RequestOption) *Iterator[*Recording]
+	IterByCallContext(context.Context, string, ...option.RequestOption) *Iterator[*Recording]
+	IterByConferenceContext(context.Context, string, ...option.RequestOption) *Iterator[*Recording]
+}
+
+// RecordingService handles communication with the Recording related methods.
+type RecordingService service
+
+// RecordingStatus is the status of a Recording.
+type RecordingStatus string
+
+// Possible values of a RecordingStatus.
+const (
+	RecordingStatusInProgress RecordingStatus = "in-progress"
+	RecordingStatusPaused     RecordingStatus = "paused"
+	RecordingStatusStopped    RecordingStatus = "stopped"
+	RecordingStatusProcessing RecordingStatus = "processing"
+	RecordingStatusCompleted  RecordingStatus = "completed"
+	RecordingStatusAbsent     RecordingStatus = "absent"
+	RecordingStatusFailed     RecordingStatus = "failed"
+	RecordingStatusDeleted    RecordingStatus = "deleted"
+)
+
+// RecordingFormat is the audio format of a downloaded Recording.
+type RecordingFormat string
+
+// Possible values of a RecordingFormat.
+const (
+	RecordingFormatWAV RecordingFormat = "wav"
+	RecordingFormatMP3 RecordingFormat = "mp3"
+)
+
+// Recording represents a Twilio recording of a Call or a Conference.
+type Recording struct {
+	Sid               string            `json:"sid"`
+	AccountSid        string            `json:"account_sid"`
+	CallSid           string            `json:"call_sid"`
+	ConferenceSid     string            `json:"conference_sid"`
+	Status            RecordingStatus   `json:"status"`
+	Source            string            `json:"source"`
+	Channels          int               `json:"channels"`
+	Duration          string            `json:"duration"`
+	Price             string            `json:"price"`
+	PriceUnit         string            `json:"price_unit"`
+	ErrorCode         int               `json:"error_code"`
+	StartTime         time.Time         `json:"start_time"`
+	DateCreated       time.Time         `json:"date_created"`
+	DateUpdated       time.Time         `json:"date_updated"`
+	APIVersion        string            `json:"api_version"`
+	URI               string            `json:"uri"`
+	SubresourceURIs   map[string]string `json:"subresource_uris"`
+	EncryptionDetails json.RawMessage   `json:"encryption_details"`
+}
+
+// UnmarshalJSON decodes a Recording, parsing its RFC 2822 dates.
+func (r *Recording) UnmarshalJSON(data []byte) error {
+	type recording Recording
+
+	raw := struct {
+		*recording
+		StartTime   string `json:"start_time"`
+		DateCreated string `json:"date_created"`
+		DateUpdated string `json:"date_updated"`
+	}{recording: (*recording)(r)}
+
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	r.StartTime, err = parseDate(raw.StartTime)
+	if err != nil {
+		return err
+	}
+
+	r.DateCreated, err = parseDate(raw.DateCreated)
+	if err != nil {
+		return err
+	}
+
+	r.DateUpdated, err = parseDate(raw.DateUpdated)
+
+	return err
+}
+
+// MarshalJSON encodes a Recording, formatting its dates in RFC 2822 like Twilio.
+func (r Recording) MarshalJSON() ([]byte, error) {
+	type recording Recording
+
+	return json.Marshal(struct {
+		recording
+		StartTime   string `json:"start_time"`
+		DateCreated string `json:"date_created"`
+		DateUpdated string `json:"date_updated"`
+	}{
+		recording:   recording(r),
+		StartTime:   formatDate(r.StartTime),
+		DateCreated: formatDate(r.DateCreated),
+		DateUpdated: formatDate(r.DateUpdated),
+	})
+}
+
+// Get performs a call to the twilio API to retrieve the metadata of a Recording with its Sid.
+// Doc: https://www.twilio.com/docs/voice/api/recording#fetch-a-recording-resource
+func (s *RecordingService) Get(sid string, requestOptions ...option.RequestOption) (*Recording, error) {
+	return s.GetContext(context.Background(), sid, requestOptions...)
+}
+
+// GetContext performs the same call as Get, bound to the given context.
+func (s *RecordingService) GetContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*Recording, error) {
+	if sid == "" {
+		return nil, ErrRecordingMissingData
+	}
+
+	res, err := s.Client.GetContext(ctx, "/Recordings/"+sid+".json", requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	recording := new(Recording)
+	err = json.Unmarshal(res, recording)
+
+	return recording, err
+}
+
+// Delete removes a Recording and its media from the account.
+// Doc: https://www.twilio.com/docs/voice/api/recording#delete-a-recording-resource
+func (s *RecordingService) Delete(sid string, requestOptions ...option.RequestOption) error {
+	return s.DeleteContext(context.Background(), sid, requestOptions...)
+}
+
+// DeleteContext performs the same call as Delete, bound to the given context.
+func (s *RecordingService) DeleteContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) error {
+	if sid == "" {
+		return ErrRecordingMissingData
+	}
+
+	return s.Client.DeleteContext(ctx, "/Recordings/"+sid+".json", requestOptions)
+}
+
+// Download streams the media of a Recording to w in the given format,
+// RecordingFormatWAV being used when empty.
+// Doc: https://www.twilio.com/docs/voice/api/recording#fetch-a-recording-media-file
+func (s *RecordingService) Download(sid string, format RecordingFormat, w io.Writer, requestOptions ...option.RequestOption) error {
+	return s.DownloadContext(context.Background(), sid, format, w, requestOptions...)
+}
+
+// DownloadContext performs the same call as Download, bound to the given context.
+func (s *RecordingService) DownloadContext(ctx context.Context, sid string, format RecordingFormat, w io.Writer, requestOptions ...option.RequestOption) error {
+	if sid == "" {
+		return ErrRecordingMissingData
+	}
+
+	if format == "" {
+		format = RecordingFormatWAV
+	}
+
+	return s.Client.DownloadContext(ctx, "/Recordings/"+sid+"."+string(format), requestOptions, w)
+}
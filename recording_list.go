@@ -0,0 +1,137 @@
+package twiliolo
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// RecordingList represents the response of the Twilio API when calling /Recordings.json
+type RecordingList struct {
+	Page            int          `json:"page"`
+	PageSize        int          `json:"page_size"`
+	URI             string       `json:"uri"`
+	FirstPageURI    string       `json:"first_page_uri"`
+	NextPageURI     string       `json:"next_page_uri"`
+	PreviousPageURI string       `json:"previous_page_uri"`
+	Recordings      []*Recording `json:"recordings"`
+}
+
+// List retrieves the first page of the Recordings of the account, filtered
+// with the DateCreated options.
+// Doc: https://www.twilio.com/docs/voice/api/recording#read-multiple-recording-resources
+func (s *RecordingService) List(requestOptions ...option.RequestOption) (*RecordingList, error) {
+	return s.ListContext(context.Background(), requestOptions...)
+}
+
+// ListContext performs the same call as List, bound to the given context.
+func (s *RecordingService) ListContext(ctx context.Context, requestOptions ...option.RequestOption) (*RecordingList, error) {
+	return s.list(ctx, "/Recordings.json", requestOptions)
+}
+
+// ListByCall retrieves the first page of the Recordings of a Call.
+// Doc: https://www.twilio.com/docs/voice/api/recording#read-multiple-recording-resources
+func (s *RecordingService) ListByCall(callSid string, requestOptions ...option.RequestOption) (*RecordingList, error) {
+	return s.ListByCallContext(context.Background(), callSid, requestOptions...)
+}
+
+// ListByCallContext performs the same call as ListByCall, bound to the given context.
+func (s *RecordingService) ListByCallContext(ctx context.Context, callSid string, requestOptions ...option.RequestOption) (*RecordingList, error) {
+	if callSid == "" {
+		return nil, ErrRecordingMissingData
+	}
+
+	return s.list(ctx, "/Calls/"+callSid+"/Recordings.json", requestOptions)
+}
+
+// ListByConference retrieves the first page of the Recordings of a Conference.
+// Doc: https://www.twilio.com/docs/voice/api/recording#read-multiple-recording-resources
+func (s *RecordingService) ListByConference(conferenceSid string, requestOptions ...option.RequestOption) (*RecordingList, error) {
+	return s.ListByConferenceContext(context.Background(), conferenceSid, requestOptions...)
+}
+
+// ListByConferenceContext performs the same call as ListByConference, bound to the given context.
+func (s *RecordingService) ListByConferenceContext(ctx context.Context, conferenceSid string, requestOptions ...option.RequestOption) (*RecordingList, error) {
+	if conferenceSid == "" {
+		return nil, ErrRecordingMissingData
+	}
+
+	return s.list(ctx, "/Conferences/"+conferenceSid+"/Recordings.json", requestOptions)
+}
+
+// ListNextPage retrieves the next page of a given RecordingList by following its NextPageURI.
+// If an empty NextPageURI is present in the struct it'll return an error
+func (s *RecordingService) ListNextPage(previousList *RecordingList) (*RecordingList, error) {
+	return s.ListNextPageContext(context.Background(), previousList)
+}
+
+// ListNextPageContext performs the same call as ListNextPage, bound to the given context.
+func (s *RecordingService) ListNextPageContext(ctx context.Context, previousList *RecordingList) (*RecordingList, error) {
+	if previousList == nil || previousList.NextPageURI == "" {
+		return nil, ErrNoNextPage
+	}
+
+	return s.list(ctx, previousList.NextPageURI, nil)
+}
+
+// Iter returns an Iterator over all the Recordings of the account matching the given options.
+func (s *RecordingService) Iter(requestOptions ...option.RequestOption) *Iterator[*Recording] {
+	return s.IterContext(context.Background(), requestOptions...)
+}
+
+// IterContext performs the same calls as Iter, bound to the given context.
+func (s *RecordingService) IterContext(ctx context.Context, requestOptions ...option.RequestOption) *Iterator[*Recording] {
+	return s.iter(ctx, "/Recordings.json", requestOptions)
+}
+
+// IterByCall returns an Iterator over all the Recordings of a Call.
+func (s *RecordingService) IterByCall(callSid string, requestOptions ...option.RequestOption) *Iterator[*Recording] {
+	return s.IterByCallContext(context.Background(), callSid, requestOptions...)
+}
+
+// IterByCallContext performs the same calls as IterByCall, bound to the given context.
+func (s *RecordingService) IterByCallContext(ctx context.Context, callSid string, requestOptions ...option.RequestOption) *Iterator[*Recording] {
+	it := s.iter(ctx, "/Calls/"+callSid+"/Recordings.json", requestOptions)
+	if callSid == "" {
+		it.err = ErrRecordingMissingData
+	}
+
+	return it
+}
+
+// IterByConference returns an Iterator over all the Recordings of a Conference.
+func (s *RecordingService) IterByConference(conferenceSid string, requestOptions ...option.RequestOption) *Iterator[*Recording] {
+	return s.IterByConferenceContext(context.Background(), conferenceSid, requestOptions...)
+}
+
+// IterByConferenceContext performs the same calls as IterByConference, bound to the given context.
+func (s *RecordingService) IterByConferenceContext(ctx context.Context, conferenceSid string, requestOptions ...option.RequestOption) *Iterator[*Recording] {
+	it := s.iter(ctx, "/Conferences/"+conferenceSid+"/Recordings.json", requestOptions)
+	if conferenceSid == "" {
+		it.err = ErrRecordingMissingData
+	}
+
+	return it
+}
+
+func (s *RecordingService) iter(ctx context.Context, uri string, requestOptions []option.RequestOption) *Iterator[*Recording] {
+	return newIterator(ctx, s.Client, uri, requestOptions, func(body []byte) ([]*Recording, string, error) {
+		list := new(RecordingList)
+		err := json.Unmarshal(body, list)
+
+		return list.Recordings, list.NextPageURI, err
+	})
+}
+
+func (s *RecordingService) list(ctx context.Context, uri string, requestOptions []option.RequestOption) (*RecordingList, error) {
+	body, err := s.Client.GetContext(ctx, uri, requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	recordingList := new(RecordingList)
+	err = json.Unmarshal(body, recordingList)
+
+	return recordingList, err
+}
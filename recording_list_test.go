@@ -0,0 +1,178 @@
+package twiliolo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+const testRecordingListResponse = `
+{
+	"page": 0,
+	"page_size": 50,
+	"next_page_uri": "\/2010-04-01\/Accounts\/TwilioloFake\/Recordings.json?Page=1&PageSize=50&PageToken=PARETwilioloFake",
+	"recordings": [{"sid": "RETwilioloFake"}, {"sid": "RETwilioloFake2"}]
+}`
+
+func TestRecordingList(t *testing.T) {
+	dateCreated := time.Date(2017, time.March, 12, 0, 0, 0, 0, time.UTC)
+
+	client := new(internal.MockAPIClient)
+	client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+		assert.Equal(t, "/Recordings.json", uri)
+
+		key, value := requestOptions[0].GetValue()
+		assert.Equal(t, "DateCreated>", key)
+		assert.Equal(t, "2017-03-12", value)
+
+		return []byte(testRecordingListResponse), nil
+	}
+
+	service := twiliolo.RecordingService{Client: client}
+	list, err := service.List(option.DateCreatedAfter(dateCreated))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(list.Recordings))
+	assert.Equal(t, "RETwilioloFake2", list.Recordings[1].Sid)
+}
+
+func TestRecordingListByCall(t *testing.T) {
+	t.Run("OK - Recordings of a call", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/Calls/CATwilioloFake/Recordings.json", uri)
+
+			return []byte(testRecordingListResponse), nil
+		}
+
+		service := twiliolo.RecordingService{Client: client}
+		list, err := service.ListByCall("CATwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(list.Recordings))
+	})
+
+	t.Run("NOK - Missing call sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.RecordingService{Client: client}
+
+		list, err := service.ListByCall("")
+
+		assert.Equal(t, twiliolo.ErrRecordingMissingData, err)
+		assert.Nil(t, list)
+	})
+}
+
+func TestRecordingListByConference(t *testing.T) {
+	client := new(internal.MockAPIClient)
+	client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+		assert.Equal(t, "/Conferences/CFTwilioloFake/Recordings.json", uri)
+
+		return []byte(testRecordingListResponse), nil
+	}
+
+	service := twiliolo.RecordingService{Client: client}
+	list, err := service.ListByConference("CFTwilioloFake")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "RETwilioloFake", list.Recordings[0].Sid)
+}
+
+func TestRecordingIterByCall(t *testing.T) {
+	t.Run("OK - Every page followed", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+			if client.GetCall == 1 {
+				assert.Equal(t, "/Calls/CATwilioloFake/Recordings.json", uri)
+
+				return []byte(testRecordingListResponse), nil
+			}
+
+			assert.Equal(t, "/2010-04-01/Accounts/TwilioloFake/Recordings.json?Page=1&PageSize=50&PageToken=PARETwilioloFake", uri)
+
+			return []byte(`{"page": 1, "recordings": [{"sid": "RETwilioloFake3"}]}`), nil
+		}
+
+		service := twiliolo.RecordingService{Client: client}
+		it := service.IterByCall("CATwilioloFake")
+
+		sids := make([]string, 0)
+		for it.Next() {
+			sids = append(sids, it.Value().Sid)
+		}
+
+		assert.NoError(t, it.Err())
+		assert.Equal(t, []string{"RETwilioloFake", "RETwilioloFake2", "RETwilioloFake3"}, sids)
+	})
+
+	t.Run("NOK - Missing call sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.RecordingService{Client: client}
+
+		it := service.IterByCall("")
+
+		assert.False(t, it.Next())
+		assert.Equal(t, twiliolo.ErrRecordingMissingData, it.Err())
+		assert.Equal(t, 0, client.GetCall)
+	})
+}
+
+func TestRecordingIterByConference(t *testing.T) {
+	t.Run("OK - Recordings of a conference", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/Conferences/CFTwilioloFake/Recordings.json", uri)
+
+			return []byte(`{"recordings": [{"sid": "RETwilioloFake"}]}`), nil
+		}
+
+		service := twiliolo.RecordingService{Client: client}
+		it := service.IterByConference("CFTwilioloFake")
+
+		assert.True(t, it.Next())
+		assert.Equal(t, "RETwilioloFake", it.Value().Sid)
+		assert.False(t, it.Next())
+		assert.NoError(t, it.Err())
+	})
+
+	t.Run("NOK - Missing conference sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.RecordingService{Client: client}
+
+		it := service.IterByConference("")
+
+		assert.False(t, it.Next())
+		assert.Equal(t, twiliolo.ErrRecordingMissingData, it.Err())
+	})
+}
+
+func TestRecordingListNextPage(t *testing.T) {
+	t.Run("OK - Follow next page URI", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/2010-04-01/Accounts/TwilioloFake/Recordings.json?Page=1&PageSize=50&PageToken=PARETwilioloFake", uri)
+			assert.Empty(t, requestOptions)
+
+			return []byte(`{"page": 1, "recordings": [{"sid": "RETwilioloFake3"}]}`), nil
+		}
+
+		service := twiliolo.RecordingService{Client: client}
+		list, err := service.ListNextPage(&twiliolo.RecordingList{NextPageURI: "/2010-04-01/Accounts/TwilioloFake/Recordings.json?Page=1&PageSize=50&PageToken=PARETwilioloFake"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "RETwilioloFake3", list.Recordings[0].Sid)
+	})
+
+	t.Run("NOK - No next page", func(t *testing.T) {
+		service := twiliolo.RecordingService{Client: new(internal.MockAPIClient)}
+
+		list, err := service.ListNextPage(&twiliolo.RecordingList{})
+
+		assert.Equal(t, twiliolo.ErrNoNextPage, err)
+		assert.Nil(t, list)
+	})
+}
@@ -0,0 +1,144 @@
+package twiliolo_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordingGet(t *testing.T) {
+	t.Run("OK - Recording retrieved", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/Recordings/RETwilioloFake.json", uri)
+
+			return []byte(`
+			{
+				"sid": "RETwilioloFake",
+				"account_sid": "TwilioloFake",
+				"call_sid": "CATwilioloFake",
+				"conference_sid": null,
+				"status": "completed",
+				"source": "OutboundAPI",
+				"channels": 2,
+				"duration": "42",
+				"price": "-0.0025",
+				"price_unit": "USD",
+				"date_created": "Mon, 16 Aug 2010 03:45:01 +0000",
+				"uri": "\/2010-04-01\/Accounts\/TwilioloFake\/Recordings\/RETwilioloFake.json",
+				"subresource_uris": {
+					"transcriptions": "\/2010-04-01\/Accounts\/TwilioloFake\/Recordings\/RETwilioloFake\/Transcriptions.json"
+				}
+			}`), nil
+		}
+
+		service := twiliolo.RecordingService{Client: client}
+		recording, err := service.Get("RETwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "CATwilioloFake", recording.CallSid)
+		assert.Equal(t, twiliolo.RecordingStatusCompleted, recording.Status)
+		assert.Equal(t, 2, recording.Channels)
+		assert.Equal(t, "42", recording.Duration)
+		assert.Equal(t, time.Date(2010, time.August, 16, 3, 45, 1, 0, time.UTC), recording.DateCreated)
+		assert.True(t, recording.StartTime.IsZero())
+	})
+
+	t.Run("NOK - Missing sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.RecordingService{Client: client}
+
+		recording, err := service.Get("")
+
+		assert.Equal(t, twiliolo.ErrRecordingMissingData, err)
+		assert.Nil(t, recording)
+	})
+}
+
+func TestRecordingMarshalJSON(t *testing.T) {
+	recording := twiliolo.Recording{
+		Sid:         "RETwilioloFake",
+		StartTime:   time.Date(2010, time.August, 16, 3, 45, 1, 0, time.UTC),
+		DateCreated: time.Date(2010, time.August, 16, 3, 45, 2, 0, time.UTC),
+	}
+
+	data, err := json.Marshal(recording)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"start_time":"Mon, 16 Aug 2010 03:45:01 +0000"`)
+
+	var decoded twiliolo.Recording
+
+	err = json.Unmarshal(data, &decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, recording.StartTime, decoded.StartTime)
+	assert.Equal(t, recording.DateCreated, decoded.DateCreated)
+	assert.True(t, decoded.DateUpdated.IsZero())
+}
+
+func TestRecordingDelete(t *testing.T) {
+	client := new(internal.MockAPIClient)
+	client.DeleteFn = func(uri string, _ []option.RequestOption) error {
+		assert.Equal(t, "/Recordings/RETwilioloFake.json", uri)
+
+		return nil
+	}
+
+	service := twiliolo.RecordingService{Client: client}
+	err := service.Delete("RETwilioloFake")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, client.DeleteCall)
+}
+
+func TestRecordingDownload(t *testing.T) {
+	t.Run("OK - MP3 downloaded", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.DownloadFn = func(uri string, _ []option.RequestOption, w io.Writer) error {
+			assert.Equal(t, "/Recordings/RETwilioloFake.mp3", uri)
+
+			_, err := w.Write([]byte("ID3 audio"))
+
+			return err
+		}
+
+		service := twiliolo.RecordingService{Client: client}
+
+		var buf bytes.Buffer
+		err := service.Download("RETwilioloFake", twiliolo.RecordingFormatMP3, &buf)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ID3 audio", buf.String())
+	})
+
+	t.Run("OK - WAV by default", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.DownloadFn = func(uri string, _ []option.RequestOption, w io.Writer) error {
+			assert.Equal(t, "/Recordings/RETwilioloFake.wav", uri)
+
+			return nil
+		}
+
+		service := twiliolo.RecordingService{Client: client}
+		err := service.Download("RETwilioloFake", "", io.Discard)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, client.DownloadCall)
+	})
+
+	t.Run("NOK - Missing sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.RecordingService{Client: client}
+
+		err := service.Download("", twiliolo.RecordingFormatWAV, io.Discard)
+
+		assert.Equal(t, twiliolo.ErrRecordingMissingData, err)
+		assert.Equal(t, 0, client.DownloadCall)
+	})
+}
@@ -0,0 +1,171 @@
+package twiliolo
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy describes how a TwilioAPIClient retries the requests failing
+// with a transient error.
+// GET and DELETE requests are retried as soon as a policy is set, POST
+// requests are only retried when RetryPost is true or when the request
+// context has been built with WithPostRetry.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry, doubled on each new attempt.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between two attempts, including the one asked by a Retry-After header.
+	MaxDelay time.Duration
+	// Jitter is the fraction (between 0 and 1) of each delay which is randomized.
+	Jitter float64
+	// RetryableStatuses lists the HTTP status codes triggering a retry.
+	RetryableStatuses []int
+	// RetryableCodes lists the Twilio error codes triggering a retry.
+	RetryableCodes []int
+	// RetryPost enables the retry of every POST request.
+	RetryPost bool
+}
+
+// DefaultRetryPolicy returns the recommended RetryPolicy: 3 attempts with
+// an exponential backoff starting at 500ms on 429 and 5XX errors.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:       3,
+		BaseDelay:         500 * time.Millisecond,
+		MaxDelay:          10 * time.Second,
+		Jitter:            0.2,
+		RetryableStatuses: []int{429, 500, 502, 503, 504},
+		RetryableCodes:    []int{20429},
+	}
+}
+
+type postRetryKey struct{}
+
+// WithPostRetry returns a copy of ctx allowing the POST requests made with it
+// to be retried according to the client RetryPolicy.
+// Only use it for requests which are safe to send twice.
+func WithPostRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, postRetryKey{}, true)
+}
+
+func (p *RetryPolicy) allowMethod(ctx context.Context, method string) bool {
+	if method != "POST" {
+		return true
+	}
+
+	allowed, _ := ctx.Value(postRetryKey{}).(bool)
+
+	return p.RetryPost || allowed
+}
+
+// retryDelay returns the delay to wait before the next attempt and whether
+// the request should be retried at all.
+func (p *RetryPolicy) retryDelay(ctx context.Context, method string, attempt int, res *http.Response, body []byte, err error) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxAttempts || ctx.Err() != nil || !p.allowMethod(ctx, method) {
+		return 0, false
+	}
+
+	if err == ErrRateLimited {
+		return 0, false
+	}
+
+	if err != nil {
+		return p.backoff(attempt), true
+	}
+
+	if !p.isRetryableStatus(res.StatusCode) && !p.isRetryableCode(body) {
+		return 0, false
+	}
+
+	if delay, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+		if p.MaxDelay > 0 && delay > p.MaxDelay {
+			delay = p.MaxDelay
+		}
+
+		return delay, true
+	}
+
+	return p.backoff(attempt), true
+}
+
+func (p *RetryPolicy) isRetryableStatus(status int) bool {
+	for _, s := range p.RetryableStatuses {
+		if s == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *RetryPolicy) isRetryableCode(body []byte) bool {
+	if len(p.RetryableCodes) == 0 {
+		return false
+	}
+
+	var twilioError TwilioError
+	if json.Unmarshal(body, &twilioError) != nil {
+		return false
+	}
+
+	for _, code := range p.RetryableCodes {
+		if code == twilioError.Code {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.BaseDelay << uint(attempt-1)
+	if delay <= 0 || (p.MaxDelay > 0 && delay > p.MaxDelay) {
+		delay = p.MaxDelay
+	}
+
+	if p.Jitter > 0 {
+		delay -= time.Duration(rand.Float64() * p.Jitter * float64(delay))
+	}
+
+	return delay
+}
+
+// parseRetryAfter reads a Retry-After header expressed either in seconds or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// wait blocks for the given delay or until ctx is done.
+func wait(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,177 @@
+package twiliolo_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+)
+
+func testRetryPolicy() *twiliolo.RetryPolicy {
+	policy := twiliolo.DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	policy.MaxDelay = 5 * time.Millisecond
+
+	return policy
+}
+
+func newStatusResponse(status int, body string, header http.Header) *http.Response {
+	return &http.Response{
+		Status:     strconv.Itoa(status),
+		StatusCode: status,
+		Body:       internal.NewRespBodyFromString(body),
+		Header:     header,
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	t.Run("OK - GET retried after a 500", func(t *testing.T) {
+		httpMock := internal.HTTPMockClient{}
+		httpMock.DoFn = func(req *http.Request) (*http.Response, error) {
+			if httpMock.DoCall < 3 {
+				return newStatusResponse(500, "", http.Header{}), nil
+			}
+
+			return newStatusResponse(200, "Success", http.Header{}), nil
+		}
+
+		client := twiliolo.NewTwilioAPIClient(ACCOUNT_SID, AUTH_TOKEN, &httpMock)
+		client.RetryPolicy = testRetryPolicy()
+		body, err := client.Get("/TestGet", make([]option.RequestOption, 0))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("Success"), body)
+		assert.Equal(t, 3, httpMock.DoCall)
+	})
+
+	t.Run("NOK - Max attempts reached", func(t *testing.T) {
+		httpMock := internal.HTTPMockClient{}
+		httpMock.DoFn = func(req *http.Request) (*http.Response, error) {
+			return newStatusResponse(500, "", http.Header{}), nil
+		}
+
+		client := twiliolo.NewTwilioAPIClient(ACCOUNT_SID, AUTH_TOKEN, &httpMock)
+		client.RetryPolicy = testRetryPolicy()
+		err := client.Delete("/TestDelete", make([]option.RequestOption, 0))
+
+		assert.True(t, errors.Is(err, twiliolo.ErrTwilioServer))
+		assert.Equal(t, 3, httpMock.DoCall)
+	})
+
+	t.Run("OK - Retryable Twilio code with Retry-After", func(t *testing.T) {
+		httpMock := internal.HTTPMockClient{}
+		httpMock.DoFn = func(req *http.Request) (*http.Response, error) {
+			if httpMock.DoCall == 1 {
+				return newStatusResponse(400, `{"status": 400, "code": 20429}`, http.Header{"Retry-After": []string{"0"}}), nil
+			}
+
+			return newStatusResponse(200, "Success", http.Header{}), nil
+		}
+
+		client := twiliolo.NewTwilioAPIClient(ACCOUNT_SID, AUTH_TOKEN, &httpMock)
+		client.RetryPolicy = testRetryPolicy()
+		body, err := client.Get("/TestGet", make([]option.RequestOption, 0))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("Success"), body)
+		assert.Equal(t, 2, httpMock.DoCall)
+	})
+
+	t.Run("NOK - Non retryable error", func(t *testing.T) {
+		httpMock := internal.HTTPMockClient{}
+		httpMock.DoFn = func(req *http.Request) (*http.Response, error) {
+			return newStatusResponse(404, `{"status": 404, "code": 20404}`, http.Header{}), nil
+		}
+
+		client := twiliolo.NewTwilioAPIClient(ACCOUNT_SID, AUTH_TOKEN, &httpMock)
+		client.RetryPolicy = testRetryPolicy()
+		_, err := client.Get("/TestGet", make([]option.RequestOption, 0))
+
+		assert.Error(t, err)
+		assert.Equal(t, 1, httpMock.DoCall)
+	})
+
+	t.Run("NOK - POST not retried by default", func(t *testing.T) {
+		httpMock := internal.HTTPMockClient{}
+		httpMock.DoFn = func(req *http.Request) (*http.Response, error) {
+			return newStatusResponse(500, "", http.Header{}), nil
+		}
+
+		client := twiliolo.NewTwilioAPIClient(ACCOUNT_SID, AUTH_TOKEN, &httpMock)
+		client.RetryPolicy = testRetryPolicy()
+		_, err := client.Post("/TestPost", make([]option.RequestOption, 0), url.Values{})
+
+		assert.True(t, errors.Is(err, twiliolo.ErrTwilioServer))
+		assert.Equal(t, 1, httpMock.DoCall)
+	})
+
+	t.Run("OK - POST retried with WithPostRetry", func(t *testing.T) {
+		httpMock := internal.HTTPMockClient{}
+		httpMock.DoFn = func(req *http.Request) (*http.Response, error) {
+			assert.NoError(t, req.ParseForm())
+			assert.Equal(t, "+3399887799", req.PostForm.Get("PhoneNumber"))
+
+			if httpMock.DoCall == 1 {
+				return newStatusResponse(503, "", http.Header{}), nil
+			}
+
+			return newStatusResponse(201, "Success", http.Header{}), nil
+		}
+
+		client := twiliolo.NewTwilioAPIClient(ACCOUNT_SID, AUTH_TOKEN, &httpMock)
+		client.RetryPolicy = testRetryPolicy()
+		values := url.Values{}
+		values.Set("PhoneNumber", "+3399887799")
+		body, err := client.PostContext(twiliolo.WithPostRetry(context.Background()), "/TestPost", make([]option.RequestOption, 0), values)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("Success"), body)
+		assert.Equal(t, 2, httpMock.DoCall)
+	})
+
+	t.Run("NOK - Context canceled while waiting", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		httpMock := internal.HTTPMockClient{}
+		httpMock.DoFn = func(req *http.Request) (*http.Response, error) {
+			cancel()
+
+			return newStatusResponse(429, "", http.Header{"Retry-After": []string{"1"}}), nil
+		}
+
+		client := twiliolo.NewTwilioAPIClient(ACCOUNT_SID, AUTH_TOKEN, &httpMock)
+		client.RetryPolicy = twiliolo.DefaultRetryPolicy()
+		_, err := client.GetContext(ctx, "/TestGet", make([]option.RequestOption, 0))
+
+		assert.Error(t, err)
+		assert.Equal(t, 1, httpMock.DoCall)
+	})
+
+	t.Run("NOK - CredentialProvider error not retried", func(t *testing.T) {
+		httpMock := internal.HTTPMockClient{}
+
+		calls := 0
+		credentials := twiliolo.CredentialProviderFunc(func(context.Context) (string, string, error) {
+			calls++
+
+			return "", "", errors.New("open /run/secrets/twilio: no such file or directory")
+		})
+
+		client := twiliolo.NewTwilioAPIClientWithCredentials(ACCOUNT_SID, credentials, &httpMock)
+		client.RetryPolicy = testRetryPolicy()
+		_, err := client.Get("/TestGet", make([]option.RequestOption, 0))
+
+		assert.EqualError(t, err, "open /run/secrets/twilio: no such file or directory")
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, 0, httpMock.DoCall)
+	})
+}
@@ -0,0 +1,135 @@
+package twiliolo
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// TranscriptionServiceInterface is the interface of a TranscriptionService
+type TranscriptionServiceInterface interface {
+	Get(string, ...option.RequestOption) (*Transcription, error)
+	Delete(string, ...option.RequestOption) error
+	List(...option.RequestOption) (*TranscriptionList, error)
+	ListByRecording(string, ...option.RequestOption) (*TranscriptionList, error)
+	ListNextPage(*TranscriptionList) (*TranscriptionList, error)
+	Iter(...option.RequestOption) *Iterator[*Transcription]
+	IterByRecording(string, ...option.RequestOption) *Iterator[*Transcription]
+	GetContext(context.Context, string, ...option.RequestOption) (*Transcription, error)
+	DeleteContext(context.Context, string, ...option.RequestOption) error
+	ListContext(context.Context, ...option.RequestOption) (*TranscriptionList, error)
+	ListByRecordingContext(context.Context, string, ...option.RequestOption) (*TranscriptionList, error)
+	ListNextPageContext(context.Context, *TranscriptionList) (*TranscriptionList, error)
+	IterContext(context.Context, ...option.RequestOption) *Iterator[*Transcription]
+	IterByRecordingContext(context.Context, string, ...option.RequestOption) *Iterator[*Transcription]
+}
+
+// TranscriptionService handles communication with the Transcription related methods.
+type TranscriptionService service
+
+// TranscriptionStatus is the status of a Transcription.
+type TranscriptionStatus string
+
+// Possible values of a TranscriptionStatus.
+const (
+	TranscriptionStatusInProgress TranscriptionStatus = "in-progress"
+	TranscriptionStatusCompleted  TranscriptionStatus = "completed"
+	TranscriptionStatusFailed     TranscriptionStatus = "failed"
+)
+
+// Transcription represents the Twilio transcription of a Recording.
+type Transcription struct {
+	Sid               string              `json:"sid"`
+	AccountSid        string              `json:"account_sid"`
+	RecordingSid      string              `json:"recording_sid"`
+	Status            TranscriptionStatus `json:"status"`
+	TranscriptionText string              `json:"transcription_text"`
+	Type              string              `json:"type"`
+	Duration          string              `json:"duration"`
+	Price             string              `json:"price"`
+	PriceUnit         string              `json:"price_unit"`
+	DateCreated       time.Time           `json:"date_created"`
+	DateUpdated       time.Time           `json:"date_updated"`
+	APIVersion        string              `json:"api_version"`
+	URI               string              `json:"uri"`
+}
+
+// UnmarshalJSON decodes a Transcription, parsing its RFC 2822 dates.
+func (t *Transcription) UnmarshalJSON(data []byte) error {
+	type transcription Transcription
+
+	raw := struct {
+		*transcription
+		DateCreated string `json:"date_created"`
+		DateUpdated string `json:"date_updated"`
+	}{transcription: (*transcription)(t)}
+
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	t.DateCreated, err = parseDate(raw.DateCreated)
+	if err != nil {
+		return err
+	}
+
+	t.DateUpdated, err = parseDate(raw.DateUpdated)
+
+	return err
+}
+
+// MarshalJSON encodes a Transcription, formatting its dates in RFC 2822 like Twilio.
+func (t Transcription) MarshalJSON() ([]byte, error) {
+	type transcription Transcription
+
+	return json.Marshal(struct {
+		transcription
+		DateCreated string `json:"date_created"`
+		DateUpdated string `json:"date_updated"`
+	}{
+		transcription: transcription(t),
+		DateCreated:   formatDate(t.DateCreated),
+		DateUpdated:   formatDate(t.DateUpdated),
+	})
+}
+
+// Get performs a call to the twilio API to retrieve a Transcription, along with its text and status.
+// Doc: https://www.twilio.com/docs/voice/api/recording-transcription#fetch-a-transcription-resource
+func (s *TranscriptionService) Get(sid string, requestOptions ...option.RequestOption) (*Transcription, error) {
+	return s.GetContext(context.Background(), sid, requestOptions...)
+}
+
+// GetContext performs the same call as Get, bound to the given context.
+func (s *TranscriptionService) GetContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) (*Transcription, error) {
+	if sid == "" {
+		return nil, ErrTranscriptionMissingData
+	}
+
+	res, err := s.Client.GetContext(ctx, "/Transcriptions/"+sid+".json", requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	transcription := new(Transcription)
+	err = json.Unmarshal(res, transcription)
+
+	return transcription, err
+}
+
+// Delete removes a Transcription from the account.
+// Doc: https://www.twilio.com/docs/voice/api/recording-transcription#delete-a-transcription-resource
+func (s *TranscriptionService) Delete(sid string, requestOptions ...option.RequestOption) error {
+	return s.DeleteContext(context.Background(), sid, requestOptions...)
+}
+
+// DeleteContext performs the same call as Delete, bound to the given context.
+func (s *TranscriptionService) DeleteContext(ctx context.Context, sid string, requestOptions ...option.RequestOption) error {
+	if sid == "" {
+		return ErrTranscriptionMissingData
+	}
+
+	return s.Client.DeleteContext(ctx, "/Transcriptions/"+sid+".json", requestOptions)
+}
@@ -0,0 +1,106 @@
+package twiliolo
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/genesor/twiliolo/option"
+)
+
+// TranscriptionList represents the response of the Twilio API when calling /Transcriptions.json
+type TranscriptionList struct {
+	Page            int              `json:"page"`
+	PageSize        int              `json:"page_size"`
+	URI             string           `json:"uri"`
+	FirstPageURI    string           `json:"first_page_uri"`
+	NextPageURI     string           `json:"next_page_uri"`
+	PreviousPageURI string           `json:"previous_page_uri"`
+	Transcriptions  []*Transcription `json:"transcriptions"`
+}
+
+// List retrieves the first page of the Transcriptions of the account.
+// Doc: https://www.twilio.com/docs/voice/api/recording-transcription#read-multiple-transcription-resources
+func (s *TranscriptionService) List(requestOptions ...option.RequestOption) (*TranscriptionList, error) {
+	return s.ListContext(context.Background(), requestOptions...)
+}
+
+// ListContext performs the same call as List, bound to the given context.
+func (s *TranscriptionService) ListContext(ctx context.Context, requestOptions ...option.RequestOption) (*TranscriptionList, error) {
+	return s.list(ctx, "/Transcriptions.json", requestOptions)
+}
+
+// ListByRecording retrieves the first page of the Transcriptions of a Recording.
+// Doc: https://www.twilio.com/docs/voice/api/recording-transcription#read-multiple-transcription-resources
+func (s *TranscriptionService) ListByRecording(recordingSid string, requestOptions ...option.RequestOption) (*TranscriptionList, error) {
+	return s.ListByRecordingContext(context.Background(), recordingSid, requestOptions...)
+}
+
+// ListByRecordingContext performs the same call as ListByRecording, bound to the given context.
+func (s *TranscriptionService) ListByRecordingContext(ctx context.Context, recordingSid string, requestOptions ...option.RequestOption) (*TranscriptionList, error) {
+	if recordingSid == "" {
+		return nil, ErrTranscriptionMissingData
+	}
+
+	return s.list(ctx, "/Recordings/"+recordingSid+"/Transcriptions.json", requestOptions)
+}
+
+// ListNextPage retrieves the next page of a given TranscriptionList by following its NextPageURI.
+// If an empty NextPageURI is present in the struct it'll return an error
+func (s *TranscriptionService) ListNextPage(previousList *TranscriptionList) (*TranscriptionList, error) {
+	return s.ListNextPageContext(context.Background(), previousList)
+}
+
+// ListNextPageContext performs the same call as ListNextPage, bound to the given context.
+func (s *TranscriptionService) ListNextPageContext(ctx context.Context, previousList *TranscriptionList) (*TranscriptionList, error) {
+	if previousList == nil || previousList.NextPageURI == "" {
+		return nil, ErrNoNextPage
+	}
+
+	return s.list(ctx, previousList.NextPageURI, nil)
+}
+
+// Iter returns an Iterator over all the Transcriptions of the account matching the given options.
+func (s *TranscriptionService) Iter(requestOptions ...option.RequestOption) *Iterator[*Transcription] {
+	return s.IterContext(context.Background(), requestOptions...)
+}
+
+// IterContext performs the same calls as Iter, bound to the given context.
+func (s *TranscriptionService) IterContext(ctx context.Context, requestOptions ...option.RequestOption) *Iterator[*Transcription] {
+	return s.iter(ctx, "/Transcriptions.json", requestOptions)
+}
+
+// IterByRecording returns an Iterator over all the Transcriptions of a Recording.
+func (s *TranscriptionService) IterByRecording(recordingSid string, requestOptions ...option.RequestOption) *Iterator[*Transcription] {
+	return s.IterByRecordingContext(context.Background(), recordingSid, requestOptions...)
+}
+
+// IterByRecordingContext performs the same calls as IterByRecording, bound to the given context.
+func (s *TranscriptionService) IterByRecordingContext(ctx context.Context, recordingSid string, requestOptions ...option.RequestOption) *Iterator[*Transcription] {
+	it := s.iter(ctx, "/Recordings/"+recordingSid+"/Transcriptions.json", requestOptions)
+	if recordingSid == "" {
+		it.err = ErrTranscriptionMissingData
+	}
+
+	return it
+}
+
+func (s *TranscriptionService) iter(ctx context.Context, uri string, requestOptions []option.RequestOption) *Iterator[*Transcription] {
+	return newIterator(ctx, s.Client, uri, requestOptions, func(body []byte) ([]*Transcription, string, error) {
+		list := new(TranscriptionList)
+		err := json.Unmarshal(body, list)
+
+		return list.Transcriptions, list.NextPageURI, err
+	})
+}
+
+func (s *TranscriptionService) list(ctx context.Context, uri string, requestOptions []option.RequestOption) (*TranscriptionList, error) {
+	body, err := s.Client.GetContext(ctx, uri, requestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	transcriptionList := new(TranscriptionList)
+	err = json.Unmarshal(body, transcriptionList)
+
+	return transcriptionList, err
+}
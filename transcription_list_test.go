@@ -0,0 +1,128 @@
+package twiliolo_test
+
+import (
+	"testing"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+const testTranscriptionListResponse = `
+{
+	"page": 0,
+	"page_size": 50,
+	"next_page_uri": "\/2010-04-01\/Accounts\/TwilioloFake\/Transcriptions.json?Page=1&PageSize=50&PageToken=PATRTwilioloFake",
+	"transcriptions": [{"sid": "TRTwilioloFake", "status": "completed"}, {"sid": "TRTwilioloFake2", "status": "failed"}]
+}`
+
+func TestTranscriptionList(t *testing.T) {
+	client := new(internal.MockAPIClient)
+	client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+		assert.Equal(t, "/Transcriptions.json", uri)
+		assert.Equal(t, []option.RequestOption{option.PageSize(50)}, requestOptions)
+
+		return []byte(testTranscriptionListResponse), nil
+	}
+
+	service := twiliolo.TranscriptionService{Client: client}
+	list, err := service.List(option.PageSize(50))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(list.Transcriptions))
+	assert.Equal(t, twiliolo.TranscriptionStatusFailed, list.Transcriptions[1].Status)
+}
+
+func TestTranscriptionListByRecording(t *testing.T) {
+	t.Run("OK - Transcriptions of a recording", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/Recordings/RETwilioloFake/Transcriptions.json", uri)
+
+			return []byte(testTranscriptionListResponse), nil
+		}
+
+		service := twiliolo.TranscriptionService{Client: client}
+		list, err := service.ListByRecording("RETwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "TRTwilioloFake", list.Transcriptions[0].Sid)
+	})
+
+	t.Run("NOK - Missing recording sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.TranscriptionService{Client: client}
+
+		list, err := service.ListByRecording("")
+
+		assert.Equal(t, twiliolo.ErrTranscriptionMissingData, err)
+		assert.Nil(t, list)
+	})
+}
+
+func TestTranscriptionIterByRecording(t *testing.T) {
+	t.Run("OK - Every page followed", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+			if client.GetCall == 1 {
+				assert.Equal(t, "/Recordings/RETwilioloFake/Transcriptions.json", uri)
+
+				return []byte(testTranscriptionListResponse), nil
+			}
+
+			assert.Equal(t, "/2010-04-01/Accounts/TwilioloFake/Transcriptions.json?Page=1&PageSize=50&PageToken=PATRTwilioloFake", uri)
+
+			return []byte(`{"page": 1, "transcriptions": [{"sid": "TRTwilioloFake3"}]}`), nil
+		}
+
+		service := twiliolo.TranscriptionService{Client: client}
+		it := service.IterByRecording("RETwilioloFake")
+
+		sids := make([]string, 0)
+		for it.Next() {
+			sids = append(sids, it.Value().Sid)
+		}
+
+		assert.NoError(t, it.Err())
+		assert.Equal(t, []string{"TRTwilioloFake", "TRTwilioloFake2", "TRTwilioloFake3"}, sids)
+	})
+
+	t.Run("NOK - Missing recording sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.TranscriptionService{Client: client}
+
+		it := service.IterByRecording("")
+
+		assert.False(t, it.Next())
+		assert.Equal(t, twiliolo.ErrTranscriptionMissingData, it.Err())
+		assert.Equal(t, 0, client.GetCall)
+	})
+}
+
+func TestTranscriptionListNextPage(t *testing.T) {
+	t.Run("OK - Follow next page URI", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, requestOptions []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/2010-04-01/Accounts/TwilioloFake/Transcriptions.json?Page=1&PageSize=50&PageToken=PATRTwilioloFake", uri)
+			assert.Empty(t, requestOptions)
+
+			return []byte(`{"page": 1, "transcriptions": [{"sid": "TRTwilioloFake3"}]}`), nil
+		}
+
+		service := twiliolo.TranscriptionService{Client: client}
+		list, err := service.ListNextPage(&twiliolo.TranscriptionList{NextPageURI: "/2010-04-01/Accounts/TwilioloFake/Transcriptions.json?Page=1&PageSize=50&PageToken=PATRTwilioloFake"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "TRTwilioloFake3", list.Transcriptions[0].Sid)
+	})
+
+	t.Run("NOK - No next page", func(t *testing.T) {
+		service := twiliolo.TranscriptionService{Client: new(internal.MockAPIClient)}
+
+		list, err := service.ListNextPage(&twiliolo.TranscriptionList{})
+
+		assert.Equal(t, twiliolo.ErrNoNextPage, err)
+		assert.Nil(t, list)
+	})
+}
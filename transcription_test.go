@@ -0,0 +1,83 @@
+package twiliolo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/internal"
+	"github.com/genesor/twiliolo/option"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTranscriptionGet(t *testing.T) {
+	t.Run("OK - Transcription retrieved", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.GetFn = func(uri string, _ []option.RequestOption) ([]byte, error) {
+			assert.Equal(t, "/Transcriptions/TRTwilioloFake.json", uri)
+
+			return []byte(`
+			{
+				"sid": "TRTwilioloFake",
+				"account_sid": "TwilioloFake",
+				"recording_sid": "RETwilioloFake",
+				"status": "completed",
+				"transcription_text": "Hello from Twiliolo",
+				"type": "fast",
+				"duration": "3",
+				"price": "-0.05",
+				"price_unit": "USD",
+				"date_created": "Mon, 16 Aug 2010 03:45:01 +0000",
+				"api_version": "2010-04-01",
+				"uri": "\/2010-04-01\/Accounts\/TwilioloFake\/Transcriptions\/TRTwilioloFake.json"
+			}`), nil
+		}
+
+		service := twiliolo.TranscriptionService{Client: client}
+		transcription, err := service.Get("TRTwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "RETwilioloFake", transcription.RecordingSid)
+		assert.Equal(t, twiliolo.TranscriptionStatusCompleted, transcription.Status)
+		assert.Equal(t, "Hello from Twiliolo", transcription.TranscriptionText)
+		assert.Equal(t, time.Date(2010, time.August, 16, 3, 45, 1, 0, time.UTC), transcription.DateCreated)
+		assert.True(t, transcription.DateUpdated.IsZero())
+	})
+
+	t.Run("NOK - Missing sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.TranscriptionService{Client: client}
+
+		transcription, err := service.Get("")
+
+		assert.Equal(t, twiliolo.ErrTranscriptionMissingData, err)
+		assert.Nil(t, transcription)
+	})
+}
+
+func TestTranscriptionDelete(t *testing.T) {
+	t.Run("OK - Transcription deleted", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		client.DeleteFn = func(uri string, _ []option.RequestOption) error {
+			assert.Equal(t, "/Transcriptions/TRTwilioloFake.json", uri)
+
+			return nil
+		}
+
+		service := twiliolo.TranscriptionService{Client: client}
+		err := service.Delete("TRTwilioloFake")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, client.DeleteCall)
+	})
+
+	t.Run("NOK - Missing sid", func(t *testing.T) {
+		client := new(internal.MockAPIClient)
+		service := twiliolo.TranscriptionService{Client: client}
+
+		err := service.Delete("")
+
+		assert.Equal(t, twiliolo.ErrTranscriptionMissingData, err)
+		assert.Equal(t, 0, client.DeleteCall)
+	})
+}
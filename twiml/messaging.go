@@ -0,0 +1,25 @@
+package twiml
+
+import "encoding/xml"
+
+// Message replies with a SMS or a MMS when Media are set.
+// Doc: https://www.twilio.com/docs/messaging/twiml/message
+type Message struct {
+	XMLName        xml.Name `xml:"Message"`
+	To             string   `xml:"to,attr,omitempty"`
+	From           string   `xml:"from,attr,omitempty"`
+	Action         string   `xml:"action,attr,omitempty"`
+	Method         string   `xml:"method,attr,omitempty"`
+	StatusCallback string   `xml:"statusCallback,attr,omitempty"`
+	Body           string   `xml:"Body,omitempty"`
+	Media          []*Media
+}
+
+// Media is the URL of a media attached to a Message.
+// Doc: https://www.twilio.com/docs/messaging/twiml/media
+type Media struct {
+	XMLName xml.Name `xml:"Media"`
+	URL     string   `xml:",chardata"`
+}
+
+func (*Message) verb() {}
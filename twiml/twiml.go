@@ -0,0 +1,69 @@
+// Package twiml builds the TwiML documents returned to Twilio by voice and
+// messaging webhooks.
+//
+//	response := twiml.NewResponse(
+//		&twiml.Say{Text: "Hello", Voice: "alice"},
+//		&twiml.Dial{Nouns: []twiml.Noun{&twiml.Number{Number: "+33612345678"}}},
+//	)
+//	response.ServeHTTP(w, r)
+package twiml
+
+import (
+	"encoding/xml"
+	"net/http"
+)
+
+// Verb is the interface implemented by every TwiML verb allowed in a Response.
+type Verb interface {
+	verb()
+}
+
+// Noun is the interface implemented by every TwiML noun allowed in a Dial.
+type Noun interface {
+	noun()
+}
+
+// GatherVerb is the interface implemented by every TwiML verb allowed in a Gather.
+type GatherVerb interface {
+	gatherVerb()
+}
+
+// Response is the root element of a TwiML document.
+type Response struct {
+	XMLName xml.Name `xml:"Response"`
+	Verbs   []Verb
+}
+
+// NewResponse instanciates a new Response containing the given verbs
+func NewResponse(verbs ...Verb) *Response {
+	return &Response{Verbs: verbs}
+}
+
+// Add appends the given verbs to the Response.
+func (r *Response) Add(verbs ...Verb) *Response {
+	r.Verbs = append(r.Verbs, verbs...)
+
+	return r
+}
+
+// Render returns the escaped XML document of the Response.
+func (r *Response) Render() ([]byte, error) {
+	body, err := xml.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), body...), nil
+}
+
+// ServeHTTP writes the Response to w, allowing a Response to be used as an http.Handler.
+func (r *Response) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	body, err := r.Render()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.Write(body)
+}
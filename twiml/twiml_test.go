@@ -0,0 +1,91 @@
+package twiml_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/genesor/twiliolo/twiml"
+)
+
+func TestResponseRender(t *testing.T) {
+	t.Run("OK - Voice response", func(t *testing.T) {
+		startOnEnter := false
+		response := twiml.NewResponse(
+			&twiml.Say{Text: "Hello & welcome <caller>", Voice: "alice", Loop: 2},
+			&twiml.Gather{
+				Input:     "dtmf speech",
+				Action:    "/gather",
+				NumDigits: 1,
+				Verbs: []twiml.GatherVerb{
+					&twiml.Play{URL: "http://foo.com/cowbell.mp3"},
+					&twiml.Pause{Length: 2},
+				},
+			},
+			&twiml.Dial{
+				CallerID: "+33612345678",
+				Nouns: []twiml.Noun{
+					&twiml.Number{Number: "+33687654321", SendDigits: "wwww1928"},
+					&twiml.Client{Identity: "joey"},
+					&twiml.Sip{URI: "sip:alice@example.com", Username: "admin"},
+					&twiml.Conference{Name: "Room 1234", StartConferenceOnEnter: &startOnEnter, EndConferenceOnExit: true},
+					&twiml.Queue{Name: "support", URL: "/about"},
+				},
+			},
+		)
+		response.Add(&twiml.Record{MaxLength: 20}, &twiml.Redirect{URL: "/next", Method: "POST"}, &twiml.Hangup{})
+
+		body, err := response.Render()
+
+		assert.NoError(t, err)
+		assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>`+"\n"+
+			`<Response>`+
+			`<Say voice="alice" loop="2">Hello &amp; welcome &lt;caller&gt;</Say>`+
+			`<Gather input="dtmf speech" action="/gather" numDigits="1"><Play>http://foo.com/cowbell.mp3</Play><Pause length="2"></Pause></Gather>`+
+			`<Dial callerId="+33612345678">`+
+			`<Number sendDigits="wwww1928">+33687654321</Number>`+
+			`<Client>joey</Client>`+
+			`<Sip username="admin">sip:alice@example.com</Sip>`+
+			`<Conference startConferenceOnEnter="false" endConferenceOnExit="true">Room 1234</Conference>`+
+			`<Queue url="/about">support</Queue>`+
+			`</Dial>`+
+			`<Record maxLength="20"></Record>`+
+			`<Redirect method="POST">/next</Redirect>`+
+			`<Hangup></Hangup>`+
+			`</Response>`, string(body))
+	})
+
+	t.Run("OK - Messaging response", func(t *testing.T) {
+		response := twiml.NewResponse(&twiml.Message{
+			Body:  "Store Location: 123 Easy St.",
+			Media: []*twiml.Media{{URL: "https://demo.twilio.com/owl.png"}},
+		})
+
+		body, err := response.Render()
+
+		assert.NoError(t, err)
+		assert.Contains(t, string(body), `<Response><Message><Body>Store Location: 123 Easy St.</Body><Media>https://demo.twilio.com/owl.png</Media></Message></Response>`)
+	})
+
+	t.Run("OK - Other verbs", func(t *testing.T) {
+		response := twiml.NewResponse(&twiml.Reject{Reason: "busy"}, &twiml.Enqueue{Name: "support", WaitURL: "/wait"}, &twiml.Dial{Number: "+33687654321"})
+
+		body, err := response.Render()
+
+		assert.NoError(t, err)
+		assert.Contains(t, string(body), `<Response><Reject reason="busy"></Reject><Enqueue waitUrl="/wait">support</Enqueue><Dial>+33687654321</Dial></Response>`)
+	})
+}
+
+func TestResponseServeHTTP(t *testing.T) {
+	response := twiml.NewResponse(&twiml.Say{Text: "Hello"})
+
+	recorder := httptest.NewRecorder()
+	response.ServeHTTP(recorder, httptest.NewRequest("POST", "/voice", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "application/xml", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>`+"\n"+`<Response><Say>Hello</Say></Response>`, recorder.Body.String())
+}
@@ -0,0 +1,200 @@
+package twiml
+
+import "encoding/xml"
+
+// Say reads a text to the caller.
+// Doc: https://www.twilio.com/docs/voice/twiml/say
+type Say struct {
+	XMLName  xml.Name `xml:"Say"`
+	Text     string   `xml:",chardata"`
+	Voice    string   `xml:"voice,attr,omitempty"`
+	Language string   `xml:"language,attr,omitempty"`
+	Loop     int      `xml:"loop,attr,omitempty"`
+}
+
+// Play plays an audio file or DTMF digits to the caller.
+// Doc: https://www.twilio.com/docs/voice/twiml/play
+type Play struct {
+	XMLName xml.Name `xml:"Play"`
+	URL     string   `xml:",chardata"`
+	Loop    int      `xml:"loop,attr,omitempty"`
+	Digits  string   `xml:"digits,attr,omitempty"`
+}
+
+// Pause waits silently for Length seconds.
+// Doc: https://www.twilio.com/docs/voice/twiml/pause
+type Pause struct {
+	XMLName xml.Name `xml:"Pause"`
+	Length  int      `xml:"length,attr,omitempty"`
+}
+
+// Dial connects the caller to another party, either the plain Number or the given Nouns.
+// Doc: https://www.twilio.com/docs/voice/twiml/dial
+type Dial struct {
+	XMLName                 xml.Name `xml:"Dial"`
+	Number                  string   `xml:",chardata"`
+	Action                  string   `xml:"action,attr,omitempty"`
+	Method                  string   `xml:"method,attr,omitempty"`
+	Timeout                 int      `xml:"timeout,attr,omitempty"`
+	HangupOnStar            bool     `xml:"hangupOnStar,attr,omitempty"`
+	TimeLimit               int      `xml:"timeLimit,attr,omitempty"`
+	CallerID                string   `xml:"callerId,attr,omitempty"`
+	Record                  string   `xml:"record,attr,omitempty"`
+	RecordingStatusCallback string   `xml:"recordingStatusCallback,attr,omitempty"`
+	AnswerOnBridge          bool     `xml:"answerOnBridge,attr,omitempty"`
+	RingTone                string   `xml:"ringTone,attr,omitempty"`
+	Nouns                   []Noun
+}
+
+// Number is a phone number dialed by a Dial.
+// Doc: https://www.twilio.com/docs/voice/twiml/number
+type Number struct {
+	XMLName              xml.Name `xml:"Number"`
+	Number               string   `xml:",chardata"`
+	SendDigits           string   `xml:"sendDigits,attr,omitempty"`
+	URL                  string   `xml:"url,attr,omitempty"`
+	Method               string   `xml:"method,attr,omitempty"`
+	StatusCallback       string   `xml:"statusCallback,attr,omitempty"`
+	StatusCallbackEvent  string   `xml:"statusCallbackEvent,attr,omitempty"`
+	StatusCallbackMethod string   `xml:"statusCallbackMethod,attr,omitempty"`
+}
+
+// Client is a Twilio Client identity dialed by a Dial.
+// Doc: https://www.twilio.com/docs/voice/twiml/client
+type Client struct {
+	XMLName              xml.Name `xml:"Client"`
+	Identity             string   `xml:",chardata"`
+	URL                  string   `xml:"url,attr,omitempty"`
+	Method               string   `xml:"method,attr,omitempty"`
+	StatusCallback       string   `xml:"statusCallback,attr,omitempty"`
+	StatusCallbackEvent  string   `xml:"statusCallbackEvent,attr,omitempty"`
+	StatusCallbackMethod string   `xml:"statusCallbackMethod,attr,omitempty"`
+}
+
+// Sip is a SIP endpoint dialed by a Dial.
+// Doc: https://www.twilio.com/docs/voice/twiml/sip
+type Sip struct {
+	XMLName              xml.Name `xml:"Sip"`
+	URI                  string   `xml:",chardata"`
+	Username             string   `xml:"username,attr,omitempty"`
+	Password             string   `xml:"password,attr,omitempty"`
+	URL                  string   `xml:"url,attr,omitempty"`
+	Method               string   `xml:"method,attr,omitempty"`
+	StatusCallback       string   `xml:"statusCallback,attr,omitempty"`
+	StatusCallbackEvent  string   `xml:"statusCallbackEvent,attr,omitempty"`
+	StatusCallbackMethod string   `xml:"statusCallbackMethod,attr,omitempty"`
+}
+
+// Conference is a conference room joined by a Dial.
+// Doc: https://www.twilio.com/docs/voice/twiml/conference
+type Conference struct {
+	XMLName                xml.Name `xml:"Conference"`
+	Name                   string   `xml:",chardata"`
+	Muted                  bool     `xml:"muted,attr,omitempty"`
+	Beep                   string   `xml:"beep,attr,omitempty"`
+	StartConferenceOnEnter *bool    `xml:"startConferenceOnEnter,attr,omitempty"`
+	EndConferenceOnExit    bool     `xml:"endConferenceOnExit,attr,omitempty"`
+	WaitURL                string   `xml:"waitUrl,attr,omitempty"`
+	WaitMethod             string   `xml:"waitMethod,attr,omitempty"`
+	MaxParticipants        int      `xml:"maxParticipants,attr,omitempty"`
+	Record                 string   `xml:"record,attr,omitempty"`
+	Coach                  string   `xml:"coach,attr,omitempty"`
+	StatusCallback         string   `xml:"statusCallback,attr,omitempty"`
+	StatusCallbackEvent    string   `xml:"statusCallbackEvent,attr,omitempty"`
+	StatusCallbackMethod   string   `xml:"statusCallbackMethod,attr,omitempty"`
+}
+
+// Queue is a call queue dequeued by a Dial.
+// Doc: https://www.twilio.com/docs/voice/twiml/queue
+type Queue struct {
+	XMLName xml.Name `xml:"Queue"`
+	Name    string   `xml:",chardata"`
+	URL     string   `xml:"url,attr,omitempty"`
+	Method  string   `xml:"method,attr,omitempty"`
+}
+
+// Gather collects the digits or speech of the caller while playing the nested verbs.
+// Doc: https://www.twilio.com/docs/voice/twiml/gather
+type Gather struct {
+	XMLName       xml.Name `xml:"Gather"`
+	Input         string   `xml:"input,attr,omitempty"`
+	Action        string   `xml:"action,attr,omitempty"`
+	Method        string   `xml:"method,attr,omitempty"`
+	Timeout       int      `xml:"timeout,attr,omitempty"`
+	FinishOnKey   string   `xml:"finishOnKey,attr,omitempty"`
+	NumDigits     int      `xml:"numDigits,attr,omitempty"`
+	SpeechTimeout string   `xml:"speechTimeout,attr,omitempty"`
+	Language      string   `xml:"language,attr,omitempty"`
+	Hints         string   `xml:"hints,attr,omitempty"`
+	Verbs         []GatherVerb
+}
+
+// Record records the voice of the caller.
+// Doc: https://www.twilio.com/docs/voice/twiml/record
+type Record struct {
+	XMLName                 xml.Name `xml:"Record"`
+	Action                  string   `xml:"action,attr,omitempty"`
+	Method                  string   `xml:"method,attr,omitempty"`
+	Timeout                 int      `xml:"timeout,attr,omitempty"`
+	FinishOnKey             string   `xml:"finishOnKey,attr,omitempty"`
+	MaxLength               int      `xml:"maxLength,attr,omitempty"`
+	PlayBeep                *bool    `xml:"playBeep,attr,omitempty"`
+	Trim                    string   `xml:"trim,attr,omitempty"`
+	RecordingStatusCallback string   `xml:"recordingStatusCallback,attr,omitempty"`
+	Transcribe              bool     `xml:"transcribe,attr,omitempty"`
+	TranscribeCallback      string   `xml:"transcribeCallback,attr,omitempty"`
+}
+
+// Redirect transfers the control of the call to the TwiML found at URL.
+// Doc: https://www.twilio.com/docs/voice/twiml/redirect
+type Redirect struct {
+	XMLName xml.Name `xml:"Redirect"`
+	URL     string   `xml:",chardata"`
+	Method  string   `xml:"method,attr,omitempty"`
+}
+
+// Hangup ends the call.
+// Doc: https://www.twilio.com/docs/voice/twiml/hangup
+type Hangup struct {
+	XMLName xml.Name `xml:"Hangup"`
+}
+
+// Reject refuses an incoming call without billing it.
+// Doc: https://www.twilio.com/docs/voice/twiml/reject
+type Reject struct {
+	XMLName xml.Name `xml:"Reject"`
+	Reason  string   `xml:"reason,attr,omitempty"`
+}
+
+// Enqueue places the caller in the queue Name.
+// Doc: https://www.twilio.com/docs/voice/twiml/enqueue
+type Enqueue struct {
+	XMLName       xml.Name `xml:"Enqueue"`
+	Name          string   `xml:",chardata"`
+	Action        string   `xml:"action,attr,omitempty"`
+	Method        string   `xml:"method,attr,omitempty"`
+	WaitURL       string   `xml:"waitUrl,attr,omitempty"`
+	WaitURLMethod string   `xml:"waitUrlMethod,attr,omitempty"`
+	WorkflowSid   string   `xml:"workflowSid,attr,omitempty"`
+}
+
+func (*Say) verb()      {}
+func (*Play) verb()     {}
+func (*Pause) verb()    {}
+func (*Dial) verb()     {}
+func (*Gather) verb()   {}
+func (*Record) verb()   {}
+func (*Redirect) verb() {}
+func (*Hangup) verb()   {}
+func (*Reject) verb()   {}
+func (*Enqueue) verb()  {}
+
+func (*Number) noun()     {}
+func (*Client) noun()     {}
+func (*Sip) noun()        {}
+func (*Conference) noun() {}
+func (*Queue) noun()      {}
+
+func (*Say) gatherVerb()   {}
+func (*Play) gatherVerb()  {}
+func (*Pause) gatherVerb() {}
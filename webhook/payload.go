@@ -0,0 +1,230 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+
+	"github.com/genesor/twiliolo"
+)
+
+// maxNumMedia is the number of media Twilio accepts in a single message.
+const maxNumMedia = 10
+
+// ErrTooManyMedia used when an IncomingMessage announces more media than Twilio accepts in a message
+var ErrTooManyMedia = errors.New("Too many media in the message")
+
+// RecordingStatus is the status of a recording sent to a recording status callback.
+type RecordingStatus = twiliolo.RecordingStatus
+
+// Possible values of a RecordingStatus sent to a recording status callback.
+const (
+	RecordingStatusInProgress = twiliolo.RecordingStatusInProgress
+	RecordingStatusCompleted  = twiliolo.RecordingStatusCompleted
+	RecordingStatusAbsent     = twiliolo.RecordingStatusAbsent
+	RecordingStatusFailed     = twiliolo.RecordingStatusFailed
+)
+
+// Geo contains the geographic data Twilio looks up for the From and To numbers.
+type Geo struct {
+	FromCity    string `form:"FromCity"`
+	FromState   string `form:"FromState"`
+	FromZip     string `form:"FromZip"`
+	FromCountry string `form:"FromCountry"`
+	ToCity      string `form:"ToCity"`
+	ToState     string `form:"ToState"`
+	ToZip       string `form:"ToZip"`
+	ToCountry   string `form:"ToCountry"`
+}
+
+// Media is a media attached to an IncomingMessage.
+type Media struct {
+	URL         string
+	ContentType string
+}
+
+// IncomingMessage is the payload sent to the SmsURL of an IncomingPhoneNumber.
+// Doc: https://www.twilio.com/docs/messaging/guides/webhook-request
+type IncomingMessage struct {
+	Geo
+	MessageSid          string                 `form:"MessageSid"`
+	AccountSid          string                 `form:"AccountSid"`
+	MessagingServiceSid string                 `form:"MessagingServiceSid"`
+	From                string                 `form:"From"`
+	To                  string                 `form:"To"`
+	Body                string                 `form:"Body"`
+	NumSegments         int                    `form:"NumSegments"`
+	NumMedia            int                    `form:"NumMedia"`
+	Status              twiliolo.MessageStatus `form:"SmsStatus"`
+	APIVersion          string                 `form:"ApiVersion"`
+	// Media is read from the MediaUrl{N} and MediaContentType{N} fields.
+	Media []Media
+}
+
+// MessageStatusCallback is the payload sent to the StatusCallback of a Message.
+// Doc: https://www.twilio.com/docs/messaging/guides/track-outbound-message-status
+type MessageStatusCallback struct {
+	MessageSid          string                 `form:"MessageSid"`
+	AccountSid          string                 `form:"AccountSid"`
+	MessagingServiceSid string                 `form:"MessagingServiceSid"`
+	From                string                 `form:"From"`
+	To                  string                 `form:"To"`
+	Status              twiliolo.MessageStatus `form:"MessageStatus"`
+	ErrorCode           int                    `form:"ErrorCode"`
+	APIVersion          string                 `form:"ApiVersion"`
+}
+
+// IncomingCall is the payload sent to the VoiceURL of an IncomingPhoneNumber.
+// Doc: https://www.twilio.com/docs/usage/webhooks/voice-webhooks
+type IncomingCall struct {
+	Geo
+	CallSid       string              `form:"CallSid"`
+	AccountSid    string              `form:"AccountSid"`
+	ParentCallSid string              `form:"ParentCallSid"`
+	From          string              `form:"From"`
+	To            string              `form:"To"`
+	Status        twiliolo.CallStatus `form:"CallStatus"`
+	Direction     string              `form:"Direction"`
+	ForwardedFrom string              `form:"ForwardedFrom"`
+	CallerName    string              `form:"CallerName"`
+	Digits        string              `form:"Digits"`
+	SpeechResult  string              `form:"SpeechResult"`
+	APIVersion    string              `form:"ApiVersion"`
+}
+
+// CallStatusCallback is the payload sent to the StatusCallback of a Call or an IncomingPhoneNumber.
+// Doc: https://www.twilio.com/docs/voice/api/call-resource#statuscallback
+type CallStatusCallback struct {
+	IncomingCall
+	CallDuration      int    `form:"CallDuration"`
+	SequenceNumber    int    `form:"SequenceNumber"`
+	Timestamp         string `form:"Timestamp"`
+	CallbackSource    string `form:"CallbackSource"`
+	RecordingURL      string `form:"RecordingUrl"`
+	RecordingSid      string `form:"RecordingSid"`
+	RecordingDuration int    `form:"RecordingDuration"`
+}
+
+// RecordingStatusCallback is the payload sent to the RecordingStatusCallback of a recorded Call.
+// Doc: https://www.twilio.com/docs/voice/api/recording#recordingstatuscallback
+type RecordingStatusCallback struct {
+	AccountSid         string          `form:"AccountSid"`
+	CallSid            string          `form:"CallSid"`
+	RecordingSid       string          `form:"RecordingSid"`
+	RecordingURL       string          `form:"RecordingUrl"`
+	Status             RecordingStatus `form:"RecordingStatus"`
+	RecordingDuration  int             `form:"RecordingDuration"`
+	RecordingChannels  int             `form:"RecordingChannels"`
+	RecordingStartTime string          `form:"RecordingStartTime"`
+	RecordingSource    string          `form:"RecordingSource"`
+	ErrorCode          int             `form:"ErrorCode"`
+}
+
+// ParseIncomingMessage reads an IncomingMessage from a webhook request.
+func ParseIncomingMessage(r *http.Request) (*IncomingMessage, error) {
+	message := new(IncomingMessage)
+	if err := parseRequest(r, message); err != nil {
+		return nil, err
+	}
+
+	if message.NumMedia > maxNumMedia {
+		return nil, ErrTooManyMedia
+	}
+
+	for i := 0; i < message.NumMedia; i++ {
+		index := strconv.Itoa(i)
+		message.Media = append(message.Media, Media{
+			URL:         r.Form.Get("MediaUrl" + index),
+			ContentType: r.Form.Get("MediaContentType" + index),
+		})
+	}
+
+	return message, nil
+}
+
+// ParseMessageStatusCallback reads a MessageStatusCallback from a webhook request.
+func ParseMessageStatusCallback(r *http.Request) (*MessageStatusCallback, error) {
+	callback := new(MessageStatusCallback)
+	if err := parseRequest(r, callback); err != nil {
+		return nil, err
+	}
+
+	return callback, nil
+}
+
+// ParseIncomingCall reads an IncomingCall from a webhook request.
+func ParseIncomingCall(r *http.Request) (*IncomingCall, error) {
+	call := new(IncomingCall)
+	if err := parseRequest(r, call); err != nil {
+		return nil, err
+	}
+
+	return call, nil
+}
+
+// ParseCallStatusCallback reads a CallStatusCallback from a webhook request.
+func ParseCallStatusCallback(r *http.Request) (*CallStatusCallback, error) {
+	callback := new(CallStatusCallback)
+	if err := parseRequest(r, callback); err != nil {
+		return nil, err
+	}
+
+	return callback, nil
+}
+
+// ParseRecordingStatusCallback reads a RecordingStatusCallback from a webhook request.
+func ParseRecordingStatusCallback(r *http.Request) (*RecordingStatusCallback, error) {
+	callback := new(RecordingStatusCallback)
+	if err := parseRequest(r, callback); err != nil {
+		return nil, err
+	}
+
+	return callback, nil
+}
+
+// parseRequest fills the fields of dst tagged with "form" from the request
+// form, including the query string when Twilio uses GET.
+func parseRequest(r *http.Request, dst interface{}) error {
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+
+	return decodeForm(r.Form, reflect.ValueOf(dst).Elem())
+}
+
+func decodeForm(form url.Values, v reflect.Value) error {
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			if err := decodeForm(form, v.Field(i)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		key := field.Tag.Get("form")
+		value := form.Get(key)
+		if key == "" || value == "" {
+			continue
+		}
+
+		switch field.Type.Kind() {
+		case reflect.String:
+			v.Field(i).SetString(value)
+		case reflect.Int:
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("Invalid %s: %s", key, err)
+			}
+			v.Field(i).SetInt(int64(n))
+		}
+	}
+
+	return nil
+}
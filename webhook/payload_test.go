@@ -0,0 +1,138 @@
+package webhook_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/genesor/twiliolo"
+	"github.com/genesor/twiliolo/webhook"
+)
+
+func newFormRequest(values url.Values) *http.Request {
+	req := httptest.NewRequest("POST", "https://mycompany.com/webhook", strings.NewReader(values.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return req
+}
+
+func TestParseIncomingMessage(t *testing.T) {
+	t.Run("OK - Message with media", func(t *testing.T) {
+		req := newFormRequest(url.Values{
+			"MessageSid":        []string{"MMTwilioloFake"},
+			"AccountSid":        []string{"TwilioloFake"},
+			"From":              []string{"+33612345678"},
+			"To":                []string{"+33687654321"},
+			"Body":              []string{"Hello"},
+			"NumSegments":       []string{"1"},
+			"NumMedia":          []string{"2"},
+			"SmsStatus":         []string{"received"},
+			"FromCity":          []string{"PARIS"},
+			"ToCountry":         []string{"FR"},
+			"MediaUrl0":         []string{"https://api.twilio.com/media/0"},
+			"MediaContentType0": []string{"image/png"},
+			"MediaUrl1":         []string{"https://api.twilio.com/media/1"},
+			"MediaContentType1": []string{"image/jpeg"},
+		})
+
+		message, err := webhook.ParseIncomingMessage(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "MMTwilioloFake", message.MessageSid)
+		assert.Equal(t, "Hello", message.Body)
+		assert.Equal(t, 1, message.NumSegments)
+		assert.Equal(t, twiliolo.MessageStatusReceived, message.Status)
+		assert.Equal(t, "PARIS", message.FromCity)
+		assert.Equal(t, "FR", message.ToCountry)
+		assert.Equal(t, []webhook.Media{
+			{URL: "https://api.twilio.com/media/0", ContentType: "image/png"},
+			{URL: "https://api.twilio.com/media/1", ContentType: "image/jpeg"},
+		}, message.Media)
+	})
+
+	t.Run("NOK - Too many media", func(t *testing.T) {
+		req := newFormRequest(url.Values{
+			"MessageSid": []string{"MMTwilioloFake"},
+			"NumMedia":   []string{"100000000"},
+		})
+
+		message, err := webhook.ParseIncomingMessage(req)
+
+		assert.Equal(t, webhook.ErrTooManyMedia, err)
+		assert.Nil(t, message)
+	})
+}
+
+func TestParseMessageStatusCallback(t *testing.T) {
+	req := newFormRequest(url.Values{
+		"MessageSid":    []string{"SMTwilioloFake"},
+		"MessageStatus": []string{"undelivered"},
+		"ErrorCode":     []string{"30003"},
+	})
+
+	callback, err := webhook.ParseMessageStatusCallback(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, twiliolo.MessageStatusUndelivered, callback.Status)
+	assert.Equal(t, 30003, callback.ErrorCode)
+}
+
+func TestParseIncomingCall(t *testing.T) {
+	req := httptest.NewRequest("GET", "https://mycompany.com/voice?CallSid=CATwilioloFake&CallStatus=ringing&Direction=inbound&FromState=CA", nil)
+
+	call, err := webhook.ParseIncomingCall(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "CATwilioloFake", call.CallSid)
+	assert.Equal(t, twiliolo.CallStatusRinging, call.Status)
+	assert.Equal(t, "inbound", call.Direction)
+	assert.Equal(t, "CA", call.FromState)
+}
+
+func TestParseCallStatusCallback(t *testing.T) {
+	t.Run("OK - Completed call", func(t *testing.T) {
+		req := newFormRequest(url.Values{
+			"CallSid":        []string{"CATwilioloFake"},
+			"CallStatus":     []string{"completed"},
+			"CallDuration":   []string{"42"},
+			"SequenceNumber": []string{"3"},
+		})
+
+		callback, err := webhook.ParseCallStatusCallback(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "CATwilioloFake", callback.CallSid)
+		assert.Equal(t, twiliolo.CallStatusCompleted, callback.Status)
+		assert.Equal(t, 42, callback.CallDuration)
+		assert.Equal(t, 3, callback.SequenceNumber)
+	})
+
+	t.Run("NOK - Invalid number", func(t *testing.T) {
+		req := newFormRequest(url.Values{"CallDuration": []string{"forty-two"}})
+
+		callback, err := webhook.ParseCallStatusCallback(req)
+
+		assert.Error(t, err)
+		assert.Nil(t, callback)
+	})
+}
+
+func TestParseRecordingStatusCallback(t *testing.T) {
+	req := newFormRequest(url.Values{
+		"RecordingSid":      []string{"RETwilioloFake"},
+		"RecordingUrl":      []string{"https://api.twilio.com/recording"},
+		"RecordingStatus":   []string{"completed"},
+		"RecordingDuration": []string{"12"},
+	})
+
+	callback, err := webhook.ParseRecordingStatusCallback(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "RETwilioloFake", callback.RecordingSid)
+	assert.Equal(t, webhook.RecordingStatusCompleted, callback.Status)
+	assert.Equal(t, 12, callback.RecordingDuration)
+}
@@ -0,0 +1,200 @@
+// Package webhook handles the HTTP requests sent by Twilio to the URLs
+// configured on the account resources, like the VoiceURL or SmsURL of an
+// IncomingPhoneNumber.
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// SignatureHeader is the header containing the signature of a Twilio request
+const SignatureHeader = "X-Twilio-Signature"
+
+var (
+	// ErrMissingSignature used when a request has no X-Twilio-Signature header
+	ErrMissingSignature = errors.New("Missing X-Twilio-Signature header")
+	// ErrInvalidSignature used when the signature of a request does not match the computed one
+	ErrInvalidSignature = errors.New("Invalid X-Twilio-Signature header")
+)
+
+// RequestValidator validates the signature of the requests sent by Twilio.
+// Doc: https://www.twilio.com/docs/usage/security#validating-requests
+type RequestValidator struct {
+	AuthToken string
+	// BaseURL replaces the scheme and host of the incoming requests, e.g.
+	// "https://example.com", when the public URL is rewritten by a proxy.
+	BaseURL string
+	// TrustForwardedHeaders rebuilds the public URL from the X-Forwarded-Proto
+	// and X-Forwarded-Host headers set by a trusted proxy.
+	TrustForwardedHeaders bool
+}
+
+// NewRequestValidator instanciates a new RequestValidator
+func NewRequestValidator(authToken string) *RequestValidator {
+	return &RequestValidator{AuthToken: authToken}
+}
+
+// Signature computes the signature of a request made to the full URL with the given POST params.
+func (v *RequestValidator) Signature(fullURL string, params url.Values) string {
+	var data strings.Builder
+	data.WriteString(fullURL)
+
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		values := append([]string(nil), params[key]...)
+		sort.Strings(values)
+
+		for _, value := range values {
+			data.WriteString(key)
+			data.WriteString(value)
+		}
+	}
+
+	mac := hmac.New(sha1.New, []byte(v.AuthToken))
+	mac.Write([]byte(data.String()))
+
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Validate checks the signature of a form encoded request made to the full URL.
+// The URL is checked both with and without its default port, as Twilio may
+// sign either of them.
+func (v *RequestValidator) Validate(fullURL string, params url.Values, signature string) bool {
+	for _, candidate := range urlVariants(fullURL) {
+		if hmac.Equal([]byte(v.Signature(candidate, params)), []byte(signature)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateBody checks the signature of a request with a JSON body, whose
+// SHA256 is sent by Twilio in the bodySHA256 query string parameter.
+func (v *RequestValidator) ValidateBody(fullURL string, body []byte, signature string) bool {
+	u, err := url.Parse(fullURL)
+	if err != nil {
+		return false
+	}
+
+	hash := sha256.Sum256(body)
+	if !hmac.Equal([]byte(hex.EncodeToString(hash[:])), []byte(u.Query().Get("bodySHA256"))) {
+		return false
+	}
+
+	return v.Validate(fullURL, nil, signature)
+}
+
+// ValidateRequest checks the signature of an incoming request, its body is
+// left readable for the next handlers.
+func (v *RequestValidator) ValidateRequest(r *http.Request) error {
+	signature := r.Header.Get(SignatureHeader)
+	if signature == "" {
+		return ErrMissingSignature
+	}
+
+	fullURL := v.requestURL(r)
+
+	if strings.Contains(r.URL.RawQuery, "bodySHA256=") {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			return err
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		if !v.ValidateBody(fullURL, body, signature) {
+			return ErrInvalidSignature
+		}
+
+		return nil
+	}
+
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+
+	if !v.Validate(fullURL, r.PostForm, signature) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// Middleware returns an http.Handler rejecting with a 403 every request
+// which is not correctly signed by Twilio before calling next.
+func (v *RequestValidator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := v.ValidateRequest(r); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// requestURL rebuilds the public URL called by Twilio.
+func (v *RequestValidator) requestURL(r *http.Request) string {
+	if v.BaseURL != "" {
+		return strings.TrimRight(v.BaseURL, "/") + r.URL.RequestURI()
+	}
+
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	host := r.Host
+
+	if v.TrustForwardedHeaders {
+		if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+			scheme = strings.TrimSpace(strings.Split(proto, ",")[0])
+		}
+		if forwardedHost := r.Header.Get("X-Forwarded-Host"); forwardedHost != "" {
+			host = strings.TrimSpace(strings.Split(forwardedHost, ",")[0])
+		}
+	}
+
+	return scheme + "://" + host + r.URL.RequestURI()
+}
+
+// urlVariants returns the URL as is, along with its variant with the default
+// port of its scheme either added or removed.
+func urlVariants(fullURL string) []string {
+	u, err := url.Parse(fullURL)
+	if err != nil {
+		return []string{fullURL}
+	}
+
+	defaultPort := "80"
+	if u.Scheme == "https" {
+		defaultPort = "443"
+	}
+
+	variant := *u
+	if port := u.Port(); port == "" {
+		variant.Host = net.JoinHostPort(u.Hostname(), defaultPort)
+	} else if port == defaultPort {
+		variant.Host = u.Hostname()
+	} else {
+		return []string{fullURL}
+	}
+
+	return []string{fullURL, variant.String()}
+}
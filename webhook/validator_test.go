@@ -0,0 +1,124 @@
+package webhook_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/genesor/twiliolo/webhook"
+)
+
+const (
+	testAuthToken = "12345"
+	testURL       = "https://mycompany.com/myapp.php?foo=1&bar=2"
+	testSignature = "0/KCTR6DLpKmkAf8muzZqo1nDgQ="
+	testBody      = `{"property": "value", "boolean": true}`
+	testBodyHash  = "0a1ff7634d9ab3b95db5c9a2dfe9416e41502b283a80c7cf19632632f96e6620"
+)
+
+var testParams = url.Values{
+	"CallSid": []string{"CA1234567890ABCDE"},
+	"Caller":  []string{"+12349013030"},
+	"Digits":  []string{"1234"},
+	"From":    []string{"+12349013030"},
+	"To":      []string{"+18005551212"},
+}
+
+func TestRequestValidatorValidate(t *testing.T) {
+	validator := webhook.NewRequestValidator(testAuthToken)
+
+	t.Run("OK - Valid signature", func(t *testing.T) {
+		assert.Equal(t, testSignature, validator.Signature(testURL, testParams))
+		assert.True(t, validator.Validate(testURL, testParams, testSignature))
+	})
+
+	t.Run("OK - Default port added by the server", func(t *testing.T) {
+		assert.True(t, validator.Validate("https://mycompany.com:443/myapp.php?foo=1&bar=2", testParams, testSignature))
+	})
+
+	t.Run("NOK - Tampered params", func(t *testing.T) {
+		params := url.Values{}
+		for key, values := range testParams {
+			params[key] = values
+		}
+		params.Set("Digits", "0000")
+
+		assert.False(t, validator.Validate(testURL, params, testSignature))
+	})
+
+	t.Run("OK - JSON body", func(t *testing.T) {
+		bodyURL := "https://mycompany.com/myapp.php?bodySHA256=" + testBodyHash
+
+		assert.True(t, validator.ValidateBody(bodyURL, []byte(testBody), "y77kIzt2vzLz71DgmJGsen2scGs="))
+		assert.False(t, validator.ValidateBody(bodyURL, []byte(`{"property": "tampered"}`), "y77kIzt2vzLz71DgmJGsen2scGs="))
+	})
+}
+
+func TestRequestValidatorMiddleware(t *testing.T) {
+	newRequest := func(signature string) *http.Request {
+		req := httptest.NewRequest("POST", "http://internal:8080/myapp.php?foo=1&bar=2", strings.NewReader(testParams.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("X-Forwarded-Proto", "https")
+		req.Header.Set("X-Forwarded-Host", "mycompany.com")
+		if signature != "" {
+			req.Header.Set(webhook.SignatureHeader, signature)
+		}
+
+		return req
+	}
+
+	nextCall := 0
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nextCall++
+		assert.Equal(t, "1234", r.PostForm.Get("Digits"))
+	})
+
+	validator := webhook.NewRequestValidator(testAuthToken)
+	validator.TrustForwardedHeaders = true
+	handler := validator.Middleware(next)
+
+	t.Run("OK - Signed request behind a proxy", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, newRequest(testSignature))
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, 1, nextCall)
+	})
+
+	t.Run("NOK - Missing signature", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, newRequest(""))
+
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+		assert.Equal(t, 1, nextCall)
+	})
+
+	t.Run("NOK - Invalid signature", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, newRequest("RSOYDt4T1cUTdK1PDd93/VVr8B8="))
+
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+		assert.Equal(t, 1, nextCall)
+	})
+
+	t.Run("OK - JSON body with base URL", func(t *testing.T) {
+		bodyValidator := webhook.NewRequestValidator(testAuthToken)
+		bodyValidator.BaseURL = "https://mycompany.com/"
+
+		req := httptest.NewRequest("POST", "http://internal:8080/myapp.php?bodySHA256="+testBodyHash, strings.NewReader(testBody))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(webhook.SignatureHeader, "y77kIzt2vzLz71DgmJGsen2scGs=")
+
+		err := bodyValidator.ValidateRequest(req)
+		assert.NoError(t, err)
+
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, testBody, string(body))
+	})
+}